@@ -1,17 +1,24 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/Jibaru/env0/pkg/commands"
+	"github.com/Jibaru/env0/pkg/scripts"
 )
 
 func main() {
 	rootCmd := &cobra.Command{Use: "env0"}
 	commands.RegisterCommands(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
+		// Propagate the exit code of commands started by run
+		var exitErr *scripts.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
@@ -5,6 +5,7 @@ go 1.24
 require (
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/spf13/cobra v1.6.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
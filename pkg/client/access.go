@@ -0,0 +1,64 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Role is the level of access a user has to an app
+type Role string
+
+// Supported roles, from the most to the least privileged
+const (
+	// RoleOwner can do anything, including deleting and transferring the app
+	RoleOwner Role = "owner"
+	// RoleAdmin can manage users besides reading and writing environments
+	RoleAdmin Role = "admin"
+	// RoleWriter can read and write environments
+	RoleWriter Role = "writer"
+	// RoleReader can only read environments
+	RoleReader Role = "reader"
+)
+
+// Roles lists every role, from the most to the least privileged
+var Roles = []Role{RoleOwner, RoleAdmin, RoleWriter, RoleReader}
+
+// ParseRole validates a role name
+func ParseRole(s string) (Role, error) {
+	role := Role(strings.ToLower(s))
+	if !slices.Contains(Roles, role) {
+		return "", fmt.Errorf("unknown role %q, expected admin, writer or reader", s)
+	}
+	return role, nil
+}
+
+// Access is what a user may do in an app and in which environments
+type Access struct {
+	Role Role `json:"role"`
+	// Envs limits access to the given environments, all of them when empty
+	Envs []string `json:"envs,omitempty"`
+}
+
+// CanWrite reports whether the access allows pushing environments
+func (a Access) CanWrite() bool {
+	return a.Role != RoleReader
+}
+
+// Allows reports whether the access covers the given environment
+func (a Access) Allows(envName string) bool {
+	return len(a.Envs) == 0 || slices.Contains(a.Envs, envName)
+}
+
+// Access returns the access of the user, deriving the role from IsOwner
+// for servers that do not report roles, where collaborators could write
+func (u AppUser) Access() Access {
+	role := u.Role
+	if role == "" {
+		role = RoleWriter
+		if u.IsOwner {
+			role = RoleOwner
+		}
+	}
+	return Access{Role: role, Envs: u.Envs}
+}
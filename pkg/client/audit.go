@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Audited actions
+const (
+	AuditRead       = "read"
+	AuditUpdate     = "update"
+	AuditAddUser    = "add_user"
+	AuditRemoveUser = "remove_user"
+)
+
+// AuditEvent is a recorded read or change of an app. It names the changed
+// keys but never their values.
+type AuditEvent struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+	Actor  string `json:"actor"`
+	// Environment is the affected environment, nil for actions on the whole app
+	Environment *string  `json:"environment,omitempty"`
+	Keys        []string `json:"keys,omitempty"`
+	// Target is the user added or removed
+	Target    string `json:"target,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// AuditFilter narrows the audit events listed, zero fields match everything
+type AuditFilter struct {
+	Env   *string
+	Since time.Time
+	User  string
+}
+
+// ListAuditEvents lists the audit events of the app, most recent first
+func (c *client) ListAuditEvents(ctx context.Context, fullAppName string, filter AuditFilter) ([]AuditEvent, error) {
+	query := url.Values{}
+	if filter.Env != nil {
+		query.Set("env", *filter.Env)
+	}
+	if !filter.Since.IsZero() {
+		query.Set("since", filter.Since.UTC().Format(time.RFC3339))
+	}
+	if filter.User != "" {
+		query.Set("user", filter.User)
+	}
+
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/audit"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, data, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return nil, &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return nil, &ClientError{Status: resp.StatusCode}
+	}
+
+	var result struct {
+		Events []AuditEvent `json:"events"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	return result.Events, nil
+}
@@ -32,13 +32,28 @@ func (e *ClientError) Error() string {
 type Client interface {
 	Signup(ctx context.Context, username, email, password string) error
 	Login(ctx context.Context, usernameOrEmail, password string) error
-	CreateApp(ctx context.Context, name string) (ownerName string, err error)
+	CreateApp(ctx context.Context, name, org string) (ownerName string, err error)
 	GetApp(ctx context.Context, fullAppName string) (envs map[string]map[string]interface{}, err error)
 	UpdateApp(ctx context.Context, fullAppName string, envs map[string]map[string]interface{}) error
-	AddUser(ctx context.Context, fullAppName, username string) error
+	DeleteApp(ctx context.Context, fullAppName string) error
+	RenameApp(ctx context.Context, fullAppName, newName string) error
+	TransferOwnership(ctx context.Context, fullAppName, username string) error
+	AddUser(ctx context.Context, fullAppName, username string, access Access) error
 	RemoveUser(ctx context.Context, fullAppName, username string) error
 	ListApps(ctx context.Context, page, limit int, sortOrder, searchTerm string) ([]App, error)
 	ListAppUsers(ctx context.Context, fullAppName string) ([]AppUser, error)
+	AddTeam(ctx context.Context, fullAppName, team string, access Access) error
+	CreateInvite(ctx context.Context, fullAppName string, invite Invite) (Invite, error)
+	ListInvites(ctx context.Context) ([]Invite, error)
+	ListAppInvites(ctx context.Context, fullAppName string) ([]Invite, error)
+	AcceptInvite(ctx context.Context, id string) error
+	DeclineInvite(ctx context.Context, id string) error
+	RevokeInvite(ctx context.Context, fullAppName, id string) error
+	ListAuditEvents(ctx context.Context, fullAppName string, filter AuditFilter) ([]AuditEvent, error)
+	CreateOrg(ctx context.Context, name string) error
+	AddOrgMember(ctx context.Context, org string, member OrgMember) error
+	RemoveOrgMember(ctx context.Context, org, username string) error
+	ListOrgMembers(ctx context.Context, org string) ([]OrgMember, error)
 }
 
 // App represents an application in the system
@@ -57,6 +72,9 @@ type AppUser struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
 	IsOwner  bool   `json:"isOwner"`
+	Role     Role   `json:"role,omitempty"`
+	// Envs are the environments the user is limited to, all of them when empty
+	Envs []string `json:"envs,omitempty"`
 }
 
 // client is the concrete implementation
@@ -160,9 +178,13 @@ func (c *client) Login(ctx context.Context, usernameOrEmail, password string) er
 	return nil
 }
 
-// CreateApp creates a new app, returns ownerName
-func (c *client) CreateApp(ctx context.Context, name string) (string, error) {
+// CreateApp creates a new app owned by the given organization, or by the
+// authenticated user when org is empty, returns ownerName
+func (c *client) CreateApp(ctx context.Context, name, org string) (string, error) {
 	body := map[string]string{"name": name}
+	if org != "" {
+		body["org"] = org
+	}
 	resp, data, err := c.doRequest(ctx, http.MethodPost, "/api/v1/apps", body)
 	if err != nil {
 		return "", err
@@ -222,10 +244,66 @@ func (c *client) UpdateApp(ctx context.Context, fullAppName string, envs map[str
 	return nil
 }
 
-// AddUser adds a user to the app
-func (c *client) AddUser(ctx context.Context, fullAppName, username string) error {
+// DeleteApp deletes the app and all of its environments
+func (c *client) DeleteApp(ctx context.Context, fullAppName string) error {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName)
+	resp, data, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// RenameApp changes the name of the app, keeping its owner
+func (c *client) RenameApp(ctx context.Context, fullAppName, newName string) error {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/name"
+	body := map[string]string{"name": newName}
+	resp, data, err := c.doRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// TransferOwnership makes another user the owner of the app
+func (c *client) TransferOwnership(ctx context.Context, fullAppName, username string) error {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/owner"
+	body := map[string]string{"username": username}
+	resp, data, err := c.doRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// AddUser adds a user to the app with the given access, or updates the access of a member
+func (c *client) AddUser(ctx context.Context, fullAppName, username string, access Access) error {
 	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/users/" + url.PathEscape(username)
-	resp, data, err := c.doRequest(ctx, http.MethodPut, path, nil)
+	resp, data, err := c.doRequest(ctx, http.MethodPut, path, access)
 	if err != nil {
 		return err
 	}
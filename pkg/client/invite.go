@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Invite is a pending invitation to access an app, addressed to a user or an email
+type Invite struct {
+	ID        string   `json:"id"`
+	App       string   `json:"app"`
+	Username  string   `json:"username,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	Role      Role     `json:"role"`
+	Envs      []string `json:"envs,omitempty"`
+	InvitedBy string   `json:"invitedBy,omitempty"`
+	CreatedAt string   `json:"createdAt,omitempty"`
+}
+
+// CreateInvite invites a user, by username or email, to the app with the access of the invite
+func (c *client) CreateInvite(ctx context.Context, fullAppName string, invite Invite) (Invite, error) {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/invites"
+	body := map[string]interface{}{"role": invite.Role, "envs": invite.Envs}
+	if invite.Username != "" {
+		body["username"] = invite.Username
+	}
+	if invite.Email != "" {
+		body["email"] = invite.Email
+	}
+	resp, data, err := c.doRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return Invite{}, err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return Invite{}, &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return Invite{}, &ClientError{Status: resp.StatusCode}
+	}
+
+	var created Invite
+	if err := json.Unmarshal(data, &created); err != nil {
+		return Invite{}, fmt.Errorf("failed to parse response: %v", err)
+	}
+	return created, nil
+}
+
+// ListInvites lists the pending invites received by the authenticated user
+func (c *client) ListInvites(ctx context.Context) ([]Invite, error) {
+	return c.listInvites(ctx, "/api/v1/invites")
+}
+
+// ListAppInvites lists the pending invites sent for the app
+func (c *client) ListAppInvites(ctx context.Context, fullAppName string) ([]Invite, error) {
+	return c.listInvites(ctx, "/api/v1/apps/"+url.PathEscape(fullAppName)+"/invites")
+}
+
+func (c *client) listInvites(ctx context.Context, path string) ([]Invite, error) {
+	resp, data, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return nil, &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return nil, &ClientError{Status: resp.StatusCode}
+	}
+
+	var result struct {
+		Invites []Invite `json:"invites"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	return result.Invites, nil
+}
+
+// AcceptInvite accepts an invite received by the authenticated user, granting its access
+func (c *client) AcceptInvite(ctx context.Context, id string) error {
+	return c.answerInvite(ctx, id, "accept")
+}
+
+// DeclineInvite declines an invite received by the authenticated user
+func (c *client) DeclineInvite(ctx context.Context, id string) error {
+	return c.answerInvite(ctx, id, "decline")
+}
+
+func (c *client) answerInvite(ctx context.Context, id, answer string) error {
+	path := "/api/v1/invites/" + url.PathEscape(id) + "/" + answer
+	resp, data, err := c.doRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// RevokeInvite cancels a pending invite sent for the app
+func (c *client) RevokeInvite(ctx context.Context, fullAppName, id string) error {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/invites/" + url.PathEscape(id)
+	resp, data, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
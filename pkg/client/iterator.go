@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"slices"
+)
+
+// DefaultPageSize is the number of apps requested per page when iterating
+const DefaultPageSize = 50
+
+// AppIterator walks every page of ListApps transparently
+type AppIterator struct {
+	c          Client
+	limit      int
+	sortOrder  string
+	searchTerm string
+
+	page    int
+	buf     []App
+	lastIDs []string
+	done    bool
+	err     error
+}
+
+// NewAppIterator returns an iterator over all apps matching the search term.
+// A non-positive limit uses DefaultPageSize.
+func NewAppIterator(c Client, limit int, sortOrder, searchTerm string) *AppIterator {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	return &AppIterator{
+		c:          c,
+		limit:      limit,
+		sortOrder:  sortOrder,
+		searchTerm: searchTerm,
+	}
+}
+
+// Next returns the next app, fetching a new page when needed.
+// It returns false when there are no more apps or an error occurred.
+func (it *AppIterator) Next(ctx context.Context) (App, bool) {
+	for len(it.buf) == 0 {
+		if it.done || it.err != nil {
+			return App{}, false
+		}
+
+		it.page++
+		apps, err := it.c.ListApps(ctx, it.page, it.limit, it.sortOrder, it.searchTerm)
+		if err != nil {
+			it.err = err
+			return App{}, false
+		}
+
+		// Servers may cap the page size below the limit, so only an empty
+		// page ends the iteration. A page repeating the previous one means
+		// paging is ignored and would otherwise loop forever.
+		ids := appIDs(apps)
+		if len(apps) == 0 || slices.Equal(ids, it.lastIDs) {
+			it.done = true
+			return App{}, false
+		}
+		it.lastIDs = ids
+		it.buf = apps
+	}
+
+	app := it.buf[0]
+	it.buf = it.buf[1:]
+	return app, true
+}
+
+// appIDs returns the ids of a page of apps, in order
+func appIDs(apps []App) []string {
+	ids := make([]string, len(apps))
+	for i, app := range apps {
+		ids[i] = app.ID
+	}
+	return ids
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *AppIterator) Err() error {
+	return it.err
+}
+
+// ListAllApps collects every page of apps using an AppIterator
+func ListAllApps(ctx context.Context, c Client, limit int, sortOrder, searchTerm string) ([]App, error) {
+	var apps []App
+	it := NewAppIterator(c, limit, sortOrder, searchTerm)
+	for {
+		app, ok := it.Next(ctx)
+		if !ok {
+			break
+		}
+		apps = append(apps, app)
+	}
+	return apps, it.Err()
+}
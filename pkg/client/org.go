@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// OrgRole is the role of a member within an organization
+type OrgRole string
+
+const (
+	// OrgRoleAdmin manages the members and apps of the organization
+	OrgRoleAdmin OrgRole = "admin"
+	// OrgRoleMember only gets the access granted to their teams
+	OrgRoleMember OrgRole = "member"
+)
+
+// ParseOrgRole validates an organization role name
+func ParseOrgRole(s string) (OrgRole, error) {
+	switch role := OrgRole(s); role {
+	case OrgRoleAdmin, OrgRoleMember:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown organization role %q, expected admin or member", s)
+	}
+}
+
+// OrgMember represents a user belonging to an organization
+type OrgMember struct {
+	Username string   `json:"username"`
+	Role     OrgRole  `json:"role"`
+	Teams    []string `json:"teams,omitempty"`
+}
+
+// CreateOrg creates an organization owned by the authenticated user
+func (c *client) CreateOrg(ctx context.Context, name string) error {
+	body := map[string]string{"name": name}
+	resp, data, err := c.doRequest(ctx, http.MethodPost, "/api/v1/orgs", body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// AddOrgMember adds a user to an organization, or updates their role and teams
+func (c *client) AddOrgMember(ctx context.Context, org string, member OrgMember) error {
+	path := "/api/v1/orgs/" + url.PathEscape(org) + "/members/" + url.PathEscape(member.Username)
+	body := map[string]interface{}{"role": member.Role, "teams": member.Teams}
+	resp, data, err := c.doRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// RemoveOrgMember removes a user from an organization and all of its teams
+func (c *client) RemoveOrgMember(ctx context.Context, org, username string) error {
+	path := "/api/v1/orgs/" + url.PathEscape(org) + "/members/" + url.PathEscape(username)
+	resp, data, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
+
+// ListOrgMembers lists the members of an organization
+func (c *client) ListOrgMembers(ctx context.Context, org string) ([]OrgMember, error) {
+	path := "/api/v1/orgs/" + url.PathEscape(org) + "/members"
+	resp, data, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return nil, &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return nil, &ClientError{Status: resp.StatusCode}
+	}
+
+	var result struct {
+		Members []OrgMember `json:"members"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	return result.Members, nil
+}
+
+// AddTeam grants a team of the organization owning the app access to it
+func (c *client) AddTeam(ctx context.Context, fullAppName, team string, access Access) error {
+	path := "/api/v1/apps/" + url.PathEscape(fullAppName) + "/teams/" + url.PathEscape(team)
+	resp, data, err := c.doRequest(ctx, http.MethodPut, path, access)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res map[string]interface{}
+		_ = json.Unmarshal(data, &res)
+		if msg, ok := res["error"].(string); ok {
+			return &ClientError{Status: resp.StatusCode, Err: errors.New(msg)}
+		}
+		return &ClientError{Status: resp.StatusCode}
+	}
+	return nil
+}
@@ -11,23 +11,67 @@ import (
 )
 
 func addUserCmd() *cobra.Command {
+	var role string
+	var envs []string
+	var team string
+	var email string
+
 	cmd := &cobra.Command{
-		Use:   "adduser <username>",
-		Args:  cobra.ExactArgs(1),
-		Short: "Add a user to the initialized Env0 app",
+		Use:   "adduser [username]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Invite a user, or add a team with --team, to the initialized Env0 app",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			targets := len(args)
+			if team != "" {
+				targets++
+			}
+			if email != "" {
+				targets++
+			}
+			if targets != 1 {
+				return fmt.Errorf("pass either a username, --email or --team")
+			}
+			var username string
+			if len(args) == 1 {
+				username = args[0]
+			}
+
 			token, err := scripts.LoadAndValidateToken()
 			if err != nil {
 				return fmt.Errorf("authentication required")
 			}
 
+			parsedRole, err := client.ParseRole(role)
+			if err != nil {
+				return err
+			}
+
+			for i, envName := range envs {
+				if envName == defaultTargetEnv {
+					envs[i] = defaultTargetEnvKey
+				}
+			}
+
 			authClient := client.New(token)
 
 			addUser := scripts.NewAddUser(authClient, logger)
 			return addUser(context.Background(), scripts.AddUserInput{
-				Username: args[0],
+				Username: username,
+				Email:    email,
+				Team:     team,
+				Role:     parsedRole,
+				Envs:     envs,
 			})
 		},
 	}
+
+	cmd.Flags().StringVar(&role, "role", string(client.RoleWriter), "Role of the user: admin, writer or reader")
+	cmd.Flags().StringVar(&email, "email", "", "Invite someone by email instead of by username")
+	cmd.Flags().StringVar(&team, "team", "", "Grant access to a team of the organization owning the app instead of a user")
+	cmd.Flags().StringSliceVar(&envs, "env", nil, "Limit the user to the given environment, repeatable (default all environments)")
 	return cmd
 }
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/prompt"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func appCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app",
+		Short: "Delete, rename or transfer apps",
+	}
+
+	cmd.AddCommand(
+		appDeleteCmd(),
+		appRenameCmd(),
+		appTransferCmd(),
+	)
+	return cmd
+}
+
+func appDeleteCmd() *cobra.Command {
+	var input scripts.AppDeleteInput
+
+	cmd := &cobra.Command{
+		Use:   "delete <fullAppName>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete an app and all of its environments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			input.FullAppName = args[0]
+			input.Interactive = prompt.IsTerminal(os.Stdin)
+			input.DryRun = dryRun
+
+			appDelete := scripts.NewAppDelete(client.New(token), logger, bufio.NewReader(os.Stdin))
+			return appDelete(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&input.Yes, "yes", "y", false, "Delete without asking for confirmation")
+	addDryRunFlag(cmd)
+	return cmd
+}
+
+func appRenameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename <fullAppName> <newName>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Rename an app, keeping its owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			appRename := scripts.NewAppRename(client.New(token), logger)
+			return appRename(context.Background(), scripts.AppRenameInput{
+				FullAppName: args[0],
+				NewName:     args[1],
+				DryRun:      dryRun,
+			})
+		},
+	}
+	addDryRunFlag(cmd)
+	return cmd
+}
+
+func appTransferCmd() *cobra.Command {
+	var input scripts.AppTransferInput
+
+	cmd := &cobra.Command{
+		Use:   "transfer <fullAppName> <username>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Make another user the owner of an app",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			input.FullAppName = args[0]
+			input.Username = args[1]
+			input.Interactive = prompt.IsTerminal(os.Stdin)
+			input.DryRun = dryRun
+
+			appTransfer := scripts.NewAppTransfer(client.New(token), logger, bufio.NewReader(os.Stdin))
+			return appTransfer(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&input.Yes, "yes", "y", false, "Transfer without asking for confirmation")
+	addDryRunFlag(cmd)
+	return cmd
+}
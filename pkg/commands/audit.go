@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func auditCmd() *cobra.Command {
+	var input scripts.AuditInput
+	var envName string
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Args:  cobra.NoArgs,
+		Short: "List who read or changed the initialized Env0 app",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed("env") {
+				if envName == defaultTargetEnv {
+					envName = defaultTargetEnvKey
+				}
+				input.EnvName = &envName
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			audit := scripts.NewAudit(client.New(token), statusLogger, renderer)
+			return audit(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVar(&envName, "env", "", "Only list events of the given environment")
+	cmd.Flags().StringVar(&input.Since, "since", "", "Only list events after a duration ago (7d, 12h) or a date (2024-01-31)")
+	cmd.Flags().StringVar(&input.User, "user", "", "Only list events of the given user")
+	return cmd
+}
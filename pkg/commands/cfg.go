@@ -14,7 +14,12 @@ func cfgCmd() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Show the env0 configuration directory path and status",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			configPath := scripts.NewConfigPath(apiClient, logger)
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			configPath := scripts.NewConfigPath(apiClient, statusLogger, renderer)
 			return configPath(context.Background(), scripts.ConfigPathInput{})
 		},
 	}
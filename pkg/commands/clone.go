@@ -11,6 +11,8 @@ import (
 )
 
 func cloneCmd() *cobra.Command {
+	var input scripts.CloneInput
+
 	cmd := &cobra.Command{
 		Use:   "clone <fullAppName>",
 		Args:  cobra.ExactArgs(1),
@@ -23,11 +25,15 @@ func cloneCmd() *cobra.Command {
 
 			authClient := client.New(token)
 
+			input.FullAppName = args[0]
+			input.DryRun = dryRun
 			clone := scripts.NewClone(authClient, logger)
-			return clone(context.Background(), scripts.CloneInput{
-				FullAppName: args[0],
-			})
+			return clone(context.Background(), input)
 		},
 	}
+
+	cmd.Flags().StringVar(&input.LayoutPattern, "layout-pattern", "", "Path of the environment files, with * standing for the environment name (default \""+scripts.DefaultLayoutPattern+"\")")
+	cmd.Flags().StringVar(&input.LayoutDefault, "layout-default", "", "Path of the default environment file (default \""+scripts.DefaultLayoutFile+"\")")
+	addDryRunFlag(cmd)
 	return cmd
 }
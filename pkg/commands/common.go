@@ -3,9 +3,64 @@ package commands
 import (
 	"log"
 	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
 
 	"github.com/Jibaru/env0/pkg/client"
+	pkglogger "github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+	"github.com/Jibaru/env0/pkg/scripts"
 )
 
 var logger = log.New(os.Stdout, "", 0)
 var apiClient = client.New("")
+
+// dryRun is bound to the --dry-run flag of the commands that support it
+var dryRun bool
+
+// reveal is bound to the global --reveal flag
+var reveal bool
+
+// outputFormat is bound to the global --output flag
+var outputFormat string
+
+// newRenderer builds the renderer selected by --output, along with the logger
+// for progress messages, which moves to stderr so structured output stays parseable
+func newRenderer() (output.Renderer, pkglogger.Logger, error) {
+	format, err := output.ParseFormat(outputFormat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusLogger pkglogger.Logger = logger
+	if format != output.Table {
+		statusLogger = log.New(os.Stderr, "", 0)
+	}
+
+	return output.New(os.Stdout, format), statusLogger, nil
+}
+
+// addDryRunFlag registers --dry-run on a command that honors it. The flag is
+// not global so commands without a dry run reject it instead of ignoring it.
+func addDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show planned file and remote changes without applying them")
+}
+
+// enterProject moves to the closest directory above the working one holding an
+// app config, so commands work from any subdirectory of a project. Relative
+// paths given by the user are made absolute first so they keep pointing to
+// the same files.
+func enterProject(paths ...*string) error {
+	for _, path := range paths {
+		if *path == "" {
+			continue
+		}
+		abs, err := filepath.Abs(*path)
+		if err != nil {
+			return err
+		}
+		*path = abs
+	}
+	return scripts.ChdirToProject()
+}
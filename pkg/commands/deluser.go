@@ -16,6 +16,10 @@ func delUserCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Remove a user from the initialized Env0 app",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
 			token, err := scripts.LoadAndValidateToken()
 			if err != nil {
 				return fmt.Errorf("authentication required")
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func exportCmd() *cobra.Command {
+	var input scripts.ExportInput
+
+	cmd := &cobra.Command{
+		Use:   "export <envName>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export an environment to another format",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(&input.File, &input.OutputPath); err != nil {
+				return err
+			}
+
+			input.EnvName = args[0]
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			// Exported data goes to stdout, so progress messages go to stderr
+			export := scripts.NewExport(authClient, log.New(os.Stderr, "", 0), os.Stdout)
+			return export(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVarP(&input.Format, "format", "f", "dotenv", "Output format: "+strings.Join(envfile.EncoderFormats(), ", "))
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Read the environment from its local env file")
+	cmd.Flags().StringVar(&input.File, "file", "", "Read the environment from the given env file")
+	cmd.Flags().StringVar(&input.OutputPath, "out", "", "Write to the given path instead of stdout")
+	cmd.Flags().BoolVar(&input.Raw, "raw", false, "Keep ${...} references unexpanded")
+	cmd.Flags().StringVar(&input.Service, "service", "", "Service name for the compose format (default \""+envfile.DefaultComposeService+"\")")
+	return cmd
+}
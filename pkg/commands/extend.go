@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func extendCmd() *cobra.Command {
+	var unset bool
+
+	cmd := &cobra.Command{
+		Use:   "extend <envName> [parentEnv]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Make an environment inherit the variables of another one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			input := scripts.ExtendInput{EnvName: args[0]}
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+
+			switch {
+			case unset && len(args) == 2:
+				return fmt.Errorf("--unset does not take a parent environment")
+			case !unset && len(args) == 1:
+				return fmt.Errorf("parent environment required, or --unset to stop inheriting")
+			case len(args) == 2:
+				input.Parent = args[1]
+			}
+
+			authClient := apiClient
+			if input.Parent != "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			input.DryRun = dryRun
+			extend := scripts.NewExtend(authClient, logger)
+			return extend(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVar(&unset, "unset", false, "Stop inheriting from the parent environment")
+	addDryRunFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func hooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks",
+		Short: "Manage the git hooks that keep env files out of commits",
+	}
+
+	cmd.AddCommand(hooksInstallCmd())
+	return cmd
+}
+
+func hooksInstallCmd() *cobra.Command {
+	var input scripts.HooksInstallInput
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Args:  cobra.NoArgs,
+		Short: "Install a pre-commit hook that runs env0 scan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			install := scripts.NewHooksInstall(logger)
+			return install(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVar(&input.Force, "force", false, "Replace an existing pre-commit hook")
+	return cmd
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/prompt"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func importCmd() *cobra.Command {
+	var input scripts.ImportInput
+
+	cmd := &cobra.Command{
+		Use:   "import <envName> <file>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Import variables from a file into a remote environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input.EnvName = args[0]
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+			input.File = args[1]
+			if err := enterProject(&input.File); err != nil {
+				return err
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			authClient := client.New(token)
+			reader := bufio.NewReader(os.Stdin)
+
+			input.Interactive = prompt.IsTerminal(os.Stdin)
+			input.DryRun = dryRun
+			input.Reveal = reveal
+			importEnv := scripts.NewImport(authClient, logger, reader)
+			return importEnv(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVarP(&input.Format, "format", "f", "", "Input format: "+strings.Join(envfile.DecoderFormats(), ", ")+" (inferred from the extension by default)")
+	cmd.Flags().StringVar(&input.Separator, "separator", "__", "Separator joining the keys of nested values")
+	cmd.Flags().BoolVar(&input.Uppercase, "uppercase", false, "Convert variable names to upper case")
+	cmd.Flags().BoolVar(&input.Replace, "replace", false, "Delete remote variables that are missing from the file")
+	cmd.Flags().BoolVarP(&input.Yes, "yes", "y", false, "Push without asking for confirmation")
+	addDryRunFlag(cmd)
+	return cmd
+}
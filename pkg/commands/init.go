@@ -10,6 +10,8 @@ import (
 )
 
 func initCmd() *cobra.Command {
+	var org string
+
 	cmd := &cobra.Command{
 		Use:   "init <appname>",
 		Args:  cobra.ExactArgs(1),
@@ -25,8 +27,11 @@ func initCmd() *cobra.Command {
 			init := scripts.NewInit(authClient, logger)
 			return init(context.Background(), scripts.InitInput{
 				AppName: args[0],
+				Org:     org,
 			})
 		},
 	}
+
+	cmd.Flags().StringVar(&org, "org", "", "Create the app under an organization you belong to")
 	return cmd
 }
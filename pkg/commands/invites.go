@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func invitesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invites",
+		Args:  cobra.NoArgs,
+		Short: "List your pending invites to apps",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listInvites(scripts.InvitesInput{})
+		},
+	}
+
+	cmd.AddCommand(
+		answerInviteCmd("accept", "Accept an invite, gaining access to its app", true),
+		answerInviteCmd("decline", "Decline an invite", false),
+		sentInvitesCmd(),
+		revokeInviteCmd(),
+	)
+	return cmd
+}
+
+func listInvites(input scripts.InvitesInput) error {
+	token, err := scripts.LoadAndValidateToken()
+	if err != nil {
+		return fmt.Errorf("authentication required")
+	}
+
+	renderer, statusLogger, err := newRenderer()
+	if err != nil {
+		return err
+	}
+
+	invites := scripts.NewInvites(client.New(token), statusLogger, renderer)
+	return invites(context.Background(), input)
+}
+
+func answerInviteCmd(use, short string, accept bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " <id>",
+		Args:  cobra.ExactArgs(1),
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			answer := scripts.NewAnswerInvite(client.New(token), logger)
+			return answer(context.Background(), scripts.AnswerInviteInput{
+				ID:     args[0],
+				Accept: accept,
+			})
+		},
+	}
+	return cmd
+}
+
+func sentInvitesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sent",
+		Args:  cobra.NoArgs,
+		Short: "List the pending invites of the initialized Env0 app",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			return listInvites(scripts.InvitesInput{Sent: true})
+		},
+	}
+	return cmd
+}
+
+func revokeInviteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke a pending invite of the initialized Env0 app",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			revoke := scripts.NewRevokeInvite(client.New(token), logger)
+			return revoke(context.Background(), scripts.RevokeInviteInput{
+				ID: args[0],
+			})
+		},
+	}
+	return cmd
+}
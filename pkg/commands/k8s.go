@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func k8sCmd() *cobra.Command {
+	var input scripts.K8sInput
+
+	cmd := &cobra.Command{
+		Use:   "k8s <envName>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Render an environment as a Kubernetes Secret or ConfigMap",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(&input.File); err != nil {
+				return err
+			}
+
+			input.EnvName = args[0]
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			// The manifest goes to stdout for piping into kubectl, so progress messages go to stderr
+			k8s := scripts.NewK8s(authClient, log.New(os.Stderr, "", 0), os.Stdout)
+			return k8s(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVar(&input.Name, "name", "", "Name of the manifest")
+	cmd.Flags().StringVarP(&input.Namespace, "namespace", "n", "", "Namespace of the manifest")
+	cmd.Flags().StringVar(&input.Kind, "kind", "secret", "Manifest kind: secret or configmap")
+	cmd.Flags().StringArrayVarP(&input.Labels, "label", "l", nil, "Label as key=value, can be repeated")
+	cmd.Flags().StringArrayVar(&input.Annotations, "annotation", nil, "Annotation as key=value, can be repeated")
+	cmd.Flags().StringSliceVar(&input.Include, "include", nil, "Only include variables matching these glob patterns")
+	cmd.Flags().StringSliceVar(&input.Exclude, "exclude", nil, "Exclude variables matching these glob patterns")
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Read the environment from its local env file")
+	cmd.Flags().StringVar(&input.File, "file", "", "Read the environment from the given env file")
+	cmd.Flags().BoolVar(&input.Raw, "raw", false, "Keep ${...} references unexpanded")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
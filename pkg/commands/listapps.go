@@ -11,6 +11,8 @@ import (
 )
 
 func listAppsCmd() *cobra.Command {
+	var input scripts.ListAppsInput
+
 	cmd := &cobra.Command{
 		Use:   "listapps",
 		Args:  cobra.NoArgs,
@@ -23,9 +25,20 @@ func listAppsCmd() *cobra.Command {
 
 			authClient := client.New(token)
 
-			listApps := scripts.NewListApps(authClient, logger)
-			return listApps(context.Background(), scripts.ListAppsInput{})
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			listApps := scripts.NewListApps(authClient, statusLogger, renderer)
+			return listApps(context.Background(), input)
 		},
 	}
+
+	cmd.Flags().StringVar(&input.Search, "search", "", "Only list apps whose name matches the search term")
+	cmd.Flags().StringVar(&input.SortOrder, "sort", "desc", "Sort order by creation date: asc or desc")
+	cmd.Flags().IntVar(&input.Limit, "limit", 0, "Maximum number of apps per page")
+	cmd.Flags().IntVar(&input.Page, "page", 0, "Page number to fetch, starting at 1")
+	cmd.Flags().BoolVar(&input.All, "all", false, "Fetch every page of apps")
 	return cmd
 }
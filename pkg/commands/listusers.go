@@ -16,6 +16,10 @@ func listUsersCmd() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "List all users with access to the initialized Env0 app",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
 			token, err := scripts.LoadAndValidateToken()
 			if err != nil {
 				return fmt.Errorf("authentication required")
@@ -23,7 +27,12 @@ func listUsersCmd() *cobra.Command {
 
 			authClient := client.New(token)
 
-			listUsers := scripts.NewListUsers(authClient, logger)
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			listUsers := scripts.NewListUsers(authClient, statusLogger, renderer)
 			return listUsers(context.Background(), scripts.ListUsersInput{})
 		},
 	}
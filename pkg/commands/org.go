@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func orgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org",
+		Short: "Manage organizations that own apps",
+	}
+
+	cmd.AddCommand(
+		orgCreateCmd(),
+		orgMembersCmd(),
+	)
+	return cmd
+}
+
+func orgCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <org>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create an organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			orgCreate := scripts.NewOrgCreate(client.New(token), logger)
+			return orgCreate(context.Background(), scripts.OrgCreateInput{
+				Name: args[0],
+			})
+		},
+	}
+	return cmd
+}
+
+func orgMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <org>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List the members of an organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			orgMembers := scripts.NewOrgMembers(client.New(token), statusLogger, renderer)
+			return orgMembers(context.Background(), scripts.OrgMembersInput{
+				Org: args[0],
+			})
+		},
+	}
+
+	cmd.AddCommand(
+		orgMembersAddCmd(),
+		orgMembersRemoveCmd(),
+	)
+	return cmd
+}
+
+func orgMembersAddCmd() *cobra.Command {
+	var role string
+	var teams []string
+
+	cmd := &cobra.Command{
+		Use:   "add <org> <username>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add a user to an organization, or update their role and teams",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			parsedRole, err := client.ParseOrgRole(role)
+			if err != nil {
+				return err
+			}
+
+			orgMemberAdd := scripts.NewOrgMemberAdd(client.New(token), logger)
+			return orgMemberAdd(context.Background(), scripts.OrgMemberAddInput{
+				Org:      args[0],
+				Username: args[1],
+				Role:     parsedRole,
+				Teams:    teams,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&role, "role", string(client.OrgRoleMember), "Role in the organization: admin or member")
+	cmd.Flags().StringSliceVar(&teams, "team", nil, "Team the user belongs to, repeatable")
+	return cmd
+}
+
+func orgMembersRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <org> <username>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove a user from an organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			orgMemberRemove := scripts.NewOrgMemberRemove(client.New(token), logger)
+			return orgMemberRemove(context.Background(), scripts.OrgMemberRemoveInput{
+				Org:      args[0],
+				Username: args[1],
+			})
+		},
+	}
+	return cmd
+}
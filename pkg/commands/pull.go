@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,6 +16,9 @@ const defaultTargetEnv string = "default"
 var defaultTargetEnvKey string = ""
 
 func pullCmd() *cobra.Command {
+	var all, watch, inherited bool
+	var interval time.Duration
+
 	cmd := &cobra.Command{
 		Use:   "pull [envName]",
 		Args:  cobra.MaximumNArgs(1),
@@ -28,6 +32,12 @@ func pullCmd() *cobra.Command {
 				}
 			}
 
+			if !all {
+				if err := enterProject(); err != nil {
+					return err
+				}
+			}
+
 			token, err := scripts.LoadAndValidateToken()
 			if err != nil {
 				return fmt.Errorf("authentication required")
@@ -38,8 +48,20 @@ func pullCmd() *cobra.Command {
 			pull := scripts.NewPull(authClient, logger)
 			return pull(context.Background(), scripts.PullInput{
 				TargetEnv: target,
+				DryRun:    dryRun,
+				Reveal:    reveal,
+				All:       all,
+				Watch:     watch,
+				Interval:  interval,
+				Inherited: inherited,
 			})
 		},
 	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Pull every app of the workspace, cloning those not initialized yet")
+	cmd.Flags().BoolVar(&watch, "watch", false, "Keep polling the app and apply remote changes to the env files")
+	cmd.Flags().DurationVar(&interval, "interval", scripts.DefaultWatchInterval, "How often to poll the app with --watch")
+	cmd.Flags().BoolVar(&inherited, "inherited", false, "Also write inherited variables into the files of child environments")
+	addDryRunFlag(cmd)
 	return cmd
 }
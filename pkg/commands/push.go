@@ -9,10 +9,15 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/prompt"
 	"github.com/Jibaru/env0/pkg/scripts"
 )
 
 func pushCmd() *cobra.Command {
+	var policy scripts.PushPolicy
+	var expand bool
+	var all bool
+
 	cmd := &cobra.Command{
 		Use:   "push [envName]",
 		Args:  cobra.MaximumNArgs(1),
@@ -26,6 +31,12 @@ func pushCmd() *cobra.Command {
 				}
 			}
 
+			if !all {
+				if err := enterProject(); err != nil {
+					return err
+				}
+			}
+
 			token, err := scripts.LoadAndValidateToken()
 			if err != nil {
 				return fmt.Errorf("authentication required")
@@ -34,11 +45,25 @@ func pushCmd() *cobra.Command {
 			authClient := client.New(token)
 			reader := bufio.NewReader(os.Stdin)
 
+			policy.DryRun = dryRun
 			push := scripts.NewPush(authClient, logger, reader)
 			return push(context.Background(), scripts.PushInput{
-				TargetEnv: target,
+				TargetEnv:   target,
+				Policy:      policy,
+				Interactive: prompt.IsTerminal(os.Stdin),
+				Expand:      expand,
+				Reveal:      reveal,
+				All:         all,
 			})
 		},
 	}
+
+	cmd.Flags().BoolVarP(&policy.Yes, "yes", "y", false, "Accept every modification and deletion without prompting")
+	cmd.Flags().BoolVar(&policy.NoDelete, "no-delete", false, "Keep remote variables that are missing locally")
+	cmd.Flags().BoolVar(&policy.OnlyAdd, "only-add", false, "Push new variables only, skipping modifications and deletions")
+	cmd.Flags().BoolVar(&policy.Force, "force", false, "Replace remote environments with the local files as-is")
+	cmd.Flags().BoolVar(&all, "all", false, "Push every app of the workspace")
+	cmd.Flags().BoolVar(&expand, "expand", false, "Push values with ${...} references resolved instead of keeping the references")
+	addDryRunFlag(cmd)
 	return cmd
 }
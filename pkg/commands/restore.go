@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func restoreCmd() *cobra.Command {
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:   "restore [backupID]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Restore environment files from a backup taken by pull",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			var backupID string
+			if len(args) == 1 {
+				backupID = args[0]
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			restore := scripts.NewRestore(statusLogger, renderer)
+			return restore(context.Background(), scripts.RestoreInput{
+				BackupID: backupID,
+				List:     list,
+				DryRun:   dryRun,
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&list, "list", false, "List the available backups")
+	addDryRunFlag(cmd)
+	return cmd
+}
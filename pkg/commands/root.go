@@ -2,18 +2,39 @@ package commands
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 func RegisterCommands(root *cobra.Command) {
+	root.PersistentFlags().BoolVar(&reveal, "reveal", false, "Show the values of sensitive variables instead of masking them")
+	root.PersistentFlags().StringVarP(&outputFormat, "output", "o", string(output.Table), "Output format for reported data: table, json or yaml")
+
 	root.AddCommand(
 		signupCmd(),
 		loginCmd(),
 		initCmd(),
 		cloneCmd(),
+		appCmd(),
+		orgCmd(),
 		pullCmd(),
 		pushCmd(),
+		restoreCmd(),
+		exportCmd(),
+		importCmd(),
+		k8sCmd(),
+		templateCmd(),
+		checkCmd(),
+		validateCmd(),
+		runCmd(),
+		statusCmd(),
+		extendCmd(),
+		hooksCmd(),
+		scanCmd(),
 		addUserCmd(),
 		delUserCmd(),
+		invitesCmd(),
+		auditCmd(),
 		versionCmd(),
 		listAppsCmd(),
 		listUsersCmd(),
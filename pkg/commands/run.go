@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func runCmd() *cobra.Command {
+	var input scripts.RunInput
+
+	cmd := &cobra.Command{
+		Use:   "run <envName> -- <command> [args...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Run a command with an environment's variables",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input.EnvName = args[0]
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+			input.Command = args[1:]
+
+			// The command runs where it was started, not in the project directory
+			dir, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			input.Dir = dir
+			if err := enterProject(&input.File); err != nil {
+				return err
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			// The command owns stdout, and its failures are not usage errors
+			cmd.SilenceUsage = true
+			run := scripts.NewRun(authClient, log.New(os.Stderr, "", 0))
+			return run(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Read the environment from its local env file")
+	cmd.Flags().StringVar(&input.File, "file", "", "Read the environment from the given env file")
+	cmd.Flags().BoolVar(&input.Watch, "watch", false, "Restart the command when the environment changes")
+	cmd.Flags().DurationVar(&input.Interval, "interval", scripts.DefaultWatchInterval, "How often to check the environment for changes with --watch")
+	cmd.Flags().StringVar(&input.Signal, "signal", "", "Send this signal (HUP, INT, QUIT or TERM) instead of restarting the command with --watch and --local or --file")
+	return cmd
+}
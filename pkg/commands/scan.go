@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func scanCmd() *cobra.Command {
+	var input scripts.ScanInput
+
+	cmd := &cobra.Command{
+		Use:   "scan",
+		Args:  cobra.NoArgs,
+		Short: "Check staged files for env files and secret values",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(); err != nil {
+				return err
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			authClient := apiClient
+			if !input.Local {
+				// The hook must not block commits of users who are logged out
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					statusLogger.Printf("%v, only checking file names", err)
+					input.Local = true
+				} else {
+					authClient = client.New(token)
+				}
+			}
+
+			cmd.SilenceUsage = true
+			scan := scripts.NewScan(authClient, statusLogger, renderer)
+			return scan(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Only check file names, without fetching the app's values")
+	return cmd
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func statusCmd() *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:     "status [envName]",
+		Aliases: []string{"diff"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Compare local environment files with the remote app",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var target *string
+			if len(args) == 1 {
+				target = &args[0]
+				if *target == defaultTargetEnv {
+					target = &defaultTargetEnvKey
+				}
+			}
+
+			if !all {
+				if err := enterProject(); err != nil {
+					return err
+				}
+			}
+
+			token, err := scripts.LoadAndValidateToken()
+			if err != nil {
+				return fmt.Errorf("authentication required")
+			}
+
+			authClient := client.New(token)
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			status := scripts.NewStatus(authClient, statusLogger, renderer)
+			return status(context.Background(), scripts.StatusInput{
+				TargetEnv: target,
+				All:       all,
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Compare every app of the workspace")
+	return cmd
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func templateCmd() *cobra.Command {
+	var input scripts.TemplateInput
+
+	cmd := &cobra.Command{
+		Use:   "template <envName>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Write an example env file with the keys of an environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The default example file lives next to the env files of the project
+			paths := []*string{&input.File}
+			if cmd.Flags().Changed("out") {
+				paths = append(paths, &input.OutputPath)
+			}
+			if err := enterProject(paths...); err != nil {
+				return err
+			}
+
+			input.EnvName = args[0]
+			if input.EnvName == defaultTargetEnv {
+				input.EnvName = defaultTargetEnvKey
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			input.DryRun = dryRun
+			template := scripts.NewTemplate(authClient, logger)
+			return template(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVar(&input.OutputPath, "out", scripts.DefaultExampleFile, "Path of the example file")
+	cmd.Flags().StringVar(&input.Placeholder, "placeholder", "", "Value written for every key")
+	cmd.Flags().BoolVar(&input.Describe, "describe", false, "Add a comment describing the kind of each value")
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Read the environment from its local env file")
+	cmd.Flags().StringVar(&input.File, "file", "", "Read the environment from the given env file")
+	addDryRunFlag(cmd)
+	return cmd
+}
+
+func checkCmd() *cobra.Command {
+	var input scripts.CheckInput
+
+	cmd := &cobra.Command{
+		Use:   "check [envName]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Compare an environment against the example env file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The default example file lives next to the env files of the project
+			paths := []*string{&input.File}
+			if cmd.Flags().Changed("example") {
+				paths = append(paths, &input.ExampleFile)
+			}
+			if err := enterProject(paths...); err != nil {
+				return err
+			}
+
+			if len(args) == 1 && args[0] != defaultTargetEnv {
+				input.EnvName = args[0]
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			// Drift is reported by the check itself, usage would only add noise in CI logs
+			cmd.SilenceUsage = true
+			check := scripts.NewCheck(authClient, statusLogger, renderer)
+			return check(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().StringVar(&input.ExampleFile, "example", scripts.DefaultExampleFile, "Path of the example file")
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Read the environment from its local env file")
+	cmd.Flags().StringVar(&input.File, "file", "", "Read the environment from the given env file")
+	return cmd
+}
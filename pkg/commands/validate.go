@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/scripts"
+)
+
+func validateCmd() *cobra.Command {
+	var input scripts.ValidateInput
+
+	cmd := &cobra.Command{
+		Use:   "validate [envName]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Validate environments against the schema in .env0",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := enterProject(&input.File); err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				input.TargetEnv = &args[0]
+				if *input.TargetEnv == defaultTargetEnv {
+					input.TargetEnv = &defaultTargetEnvKey
+				}
+			}
+
+			authClient := apiClient
+			if !input.Local && input.File == "" {
+				token, err := scripts.LoadAndValidateToken()
+				if err != nil {
+					return fmt.Errorf("authentication required")
+				}
+				authClient = client.New(token)
+			}
+
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			// Invalid variables are reported by the validation itself
+			cmd.SilenceUsage = true
+			validate := scripts.NewValidate(authClient, statusLogger, renderer)
+			return validate(context.Background(), input)
+		},
+	}
+
+	cmd.Flags().BoolVar(&input.Local, "local", false, "Validate the local env files")
+	cmd.Flags().StringVar(&input.File, "file", "", "Validate the given env file")
+	return cmd
+}
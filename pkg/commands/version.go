@@ -14,7 +14,12 @@ func versionCmd() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Get version",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			version := scripts.NewVersion(logger)
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
+			version := scripts.NewVersion(statusLogger, renderer)
 			return version(context.Background())
 		},
 	}
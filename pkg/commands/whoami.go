@@ -14,8 +14,13 @@ func whoamiCmd() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Display information about the current user",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			renderer, statusLogger, err := newRenderer()
+			if err != nil {
+				return err
+			}
+
 			// We don't validate token here since we want to show "not authenticated" status
-			whoami := scripts.NewWhoAmI(apiClient, logger)
+			whoami := scripts.NewWhoAmI(apiClient, statusLogger, renderer)
 			return whoami(context.Background(), scripts.WhoAmIInput{})
 		},
 	}
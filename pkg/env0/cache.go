@@ -0,0 +1,64 @@
+package env0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/envfile"
+)
+
+// cachePath returns the file caching the environment, named after the app and environment
+func (l *Loader) cachePath() string {
+	name := strings.ReplaceAll(l.opts.App, "/", "_") + "." + envfile.DisplayName(l.opts.Env) + ".json"
+	return filepath.Join(l.opts.CacheDir, name)
+}
+
+// writeCache stores the variables in the cache directory, readable by the current user only
+func (l *Loader) writeCache(vars map[string]string) error {
+	if l.opts.CacheDir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(l.opts.CacheDir, 0700); err != nil {
+		return fmt.Errorf("env0: failed to create cache directory: %v", err)
+	}
+
+	data, err := json.Marshal(vars)
+	if err != nil {
+		return fmt.Errorf("env0: failed to encode cache: %v", err)
+	}
+	if err := envfile.WriteFileAtomic(l.cachePath(), data); err != nil {
+		return fmt.Errorf("env0: failed to write cache: %v", err)
+	}
+	return nil
+}
+
+// storeCache writes the cache, reporting failures to OnError since the
+// fetched variables are usable without it
+func (l *Loader) storeCache(vars map[string]string) {
+	if err := l.writeCache(vars); err != nil && l.opts.OnError != nil {
+		l.opts.OnError(err)
+	}
+}
+
+// readCache loads the variables stored by writeCache
+func (l *Loader) readCache() (map[string]string, error) {
+	if l.opts.CacheDir == "" {
+		return nil, errors.New("env0: cache disabled")
+	}
+
+	data, err := os.ReadFile(l.cachePath())
+	if err != nil {
+		return nil, err
+	}
+
+	var vars map[string]string
+	if err := json.Unmarshal(data, &vars); err != nil {
+		return nil, fmt.Errorf("env0: invalid cache %s: %v", l.cachePath(), err)
+	}
+	return vars, nil
+}
@@ -0,0 +1,283 @@
+// Package env0 loads an environment of an env0 app into a running
+// application, as an alternative to reading the .env files written by pull.
+//
+//	loader, err := env0.Load(ctx, env0.Options{App: "acme/api", Env: "prod"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	var cfg Config
+//	if err := loader.Bind(&cfg); err != nil {
+//		log.Fatal(err)
+//	}
+package env0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+)
+
+// TokenEnvVar holds the service token when Options.Token is empty
+const TokenEnvVar = "ENV0_TOKEN"
+
+// Options configures a Loader
+type Options struct {
+	// App is the full name of the app, as owner/app
+	App string
+	// Env is the environment to load, "" or "default" for the default one
+	Env string
+	// Token authenticates the requests, read from ENV0_TOKEN when empty
+	Token string
+	// Client overrides the API client built from the token
+	Client client.Client
+	// CacheDir keeps the last fetched environment on disk, so the application
+	// can start while the API is unreachable. Caching is disabled when empty.
+	CacheDir string
+	// Raw keeps ${...} references unexpanded
+	Raw bool
+	// Override lets Setenv replace variables already set in the process
+	Override bool
+	// RefreshInterval is how often Start fetches the environment again
+	RefreshInterval time.Duration
+	// OnChange is called by Start with the previous and the new variables when they change
+	OnChange func(old, new map[string]string)
+	// OnError is called by Start when a refresh fails, the previous variables
+	// are kept, and by Load and Start when the cache cannot be written
+	OnError func(error)
+}
+
+// errUnreachable marks fetch errors caused by not reaching the API, the only
+// ones the cache stands in for
+var errUnreachable = errors.New("env0: API unreachable")
+
+// Loader fetches an environment and keeps it up to date
+type Loader struct {
+	opts   Options
+	client client.Client
+
+	mu        sync.RWMutex
+	vars      map[string]string
+	fromCache bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New creates a loader without fetching the environment yet
+func New(opts Options) (*Loader, error) {
+	if opts.App == "" {
+		return nil, errors.New("env0: app name is required")
+	}
+	if opts.Env == "default" {
+		opts.Env = ""
+	}
+
+	c := opts.Client
+	if c == nil {
+		token := opts.Token
+		if token == "" {
+			token = os.Getenv(TokenEnvVar)
+		}
+		if token == "" {
+			return nil, fmt.Errorf("env0: a token is required, set Options.Token or %s", TokenEnvVar)
+		}
+		c = client.New(token)
+	}
+
+	return &Loader{opts: opts, client: c}, nil
+}
+
+// Load creates a loader and fetches the environment
+func Load(ctx context.Context, opts Options) (*Loader, error) {
+	l, err := New(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Load fetches the environment. When the API cannot be reached the cached
+// copy is used, if any, and FromCache reports it. Answers of the API, like a
+// rejected token or a missing app, are returned as errors instead.
+func (l *Loader) Load(ctx context.Context) error {
+	vars, err := l.fetch(ctx)
+	if err == nil {
+		l.set(vars, false)
+		l.storeCache(vars)
+		return nil
+	}
+	if !errors.Is(err, errUnreachable) {
+		return err
+	}
+
+	cached, cacheErr := l.readCache()
+	if cacheErr != nil {
+		return err
+	}
+	l.set(cached, true)
+	return nil
+}
+
+// Vars returns a copy of the variables
+func (l *Loader) Vars() map[string]string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return maps.Clone(l.vars)
+}
+
+// Get returns the value of a variable
+func (l *Loader) Get(key string) (string, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	value, ok := l.vars[key]
+	return value, ok
+}
+
+// FromCache reports whether the variables come from the disk cache instead of the API
+func (l *Loader) FromCache() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.fromCache
+}
+
+// Setenv sets the variables in the process environment. Variables already
+// set are kept unless Options.Override is set.
+func (l *Loader) Setenv() error {
+	for key, value := range l.Vars() {
+		if _, exists := os.LookupEnv(key); exists && !l.opts.Override {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("env0: failed to set %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the variables with typed accessors
+func (l *Loader) Values() envfile.Values {
+	values := make(envfile.Values)
+	for key, value := range l.Vars() {
+		values[key] = value
+	}
+	return values
+}
+
+// Bind sets the fields of the struct dst points to from their env tags,
+// reporting every invalid variable at once, see envfile.Bind
+func (l *Loader) Bind(dst interface{}) error {
+	return l.Values().Bind(dst)
+}
+
+// Start refreshes the environment every Options.RefreshInterval in the
+// background until ctx is done or Stop is called
+func (l *Loader) Start(ctx context.Context) error {
+	if l.opts.RefreshInterval <= 0 {
+		return errors.New("env0: RefreshInterval must be positive to start refreshing")
+	}
+	if l.stop != nil {
+		return errors.New("env0: loader already started")
+	}
+
+	l.stop = make(chan struct{})
+	l.done = make(chan struct{})
+	go l.refreshLoop(ctx)
+	return nil
+}
+
+// Stop stops the refreshes started by Start and waits for the current one to finish
+func (l *Loader) Stop() {
+	if l.stop == nil {
+		return
+	}
+	close(l.stop)
+	<-l.done
+	l.stop = nil
+}
+
+func (l *Loader) refreshLoop(ctx context.Context) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.opts.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			if err := l.refresh(ctx); err != nil && l.opts.OnError != nil {
+				l.opts.OnError(err)
+			}
+		}
+	}
+}
+
+// refresh fetches the environment and reports it when it changed
+func (l *Loader) refresh(ctx context.Context) error {
+	vars, err := l.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	old := l.Vars()
+	l.set(vars, false)
+	if maps.Equal(old, vars) {
+		return nil
+	}
+
+	l.storeCache(vars)
+	if l.opts.OnChange != nil {
+		l.opts.OnChange(old, maps.Clone(vars))
+	}
+	return nil
+}
+
+// fetch reads the environment from the API, expanding its references
+func (l *Loader) fetch(ctx context.Context) (map[string]string, error) {
+	envs, err := l.client.GetApp(ctx, l.opts.App)
+	if err != nil {
+		var clientErr *client.ClientError
+		if !errors.As(err, &clientErr) {
+			return nil, fmt.Errorf("%w: failed to fetch app %s: %v", errUnreachable, l.opts.App, err)
+		}
+		return nil, fmt.Errorf("env0: failed to fetch app %s: %v", l.opts.App, err)
+	}
+
+	env, ok := envs[l.opts.Env]
+	if !ok {
+		return nil, fmt.Errorf("env0: environment %s not found in app %s", envfile.DisplayName(l.opts.Env), l.opts.App)
+	}
+
+	if !l.opts.Raw {
+		env, err = envfile.NewExpander(envs, os.LookupEnv).Env(l.opts.Env)
+		if err != nil {
+			return nil, fmt.Errorf("env0: %v", err)
+		}
+	}
+
+	vars := make(map[string]string, len(env))
+	for key, value := range env {
+		vars[key] = fmt.Sprintf("%v", value)
+	}
+	return vars, nil
+}
+
+func (l *Loader) set(vars map[string]string, fromCache bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.vars = vars
+	l.fromCache = fromCache
+}
@@ -0,0 +1,207 @@
+package envfile
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Batch stages several files and replaces them together, so either every
+// file is updated or none is
+type Batch struct {
+	staged []stagedFile
+}
+
+type stagedFile struct {
+	// target is the name the file was staged with, path the file it resolves to
+	target string
+	path   string
+	temp   string
+}
+
+// NewBatch creates an empty batch of staged files
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Stage writes content to a synced temporary file next to the target. A
+// symlinked target is resolved so the file it points to is replaced instead
+// of the link. The staged file keeps the mode of the current target, new
+// files are only readable by their owner since they usually hold secrets.
+func (b *Batch) Stage(target string, content []byte) error {
+	path, err := resolveTarget(target)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %v", target, err)
+	}
+
+	perm := os.FileMode(0600)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	temp, err := writeTempFile(dir, filepath.Base(path), content, perm)
+	if err != nil {
+		return fmt.Errorf("failed to stage %s: %v", target, err)
+	}
+
+	b.staged = append(b.staged, stagedFile{target: target, path: path, temp: temp})
+	return nil
+}
+
+// Len returns the number of staged files
+func (b *Batch) Len() int {
+	return len(b.staged)
+}
+
+// Commit copies the current contents of every target into backupDir, when
+// it is not empty, and then renames the staged files over their targets
+func (b *Batch) Commit(backupDir string) error {
+	if backupDir != "" {
+		for _, f := range b.staged {
+			if err := backupFile(f.target, backupDir); err != nil {
+				b.Rollback()
+				return err
+			}
+		}
+	}
+
+	for i, f := range b.staged {
+		if err := os.Rename(f.temp, f.path); err != nil {
+			// Earlier renames already happened, only the remaining temp files can be discarded
+			b.staged = b.staged[i:]
+			b.Rollback()
+			return fmt.Errorf("failed to replace %s: %v", f.target, err)
+		}
+	}
+
+	b.staged = nil
+	return nil
+}
+
+// Rollback discards every staged file without touching the targets
+func (b *Batch) Rollback() {
+	for _, f := range b.staged {
+		os.Remove(f.temp)
+	}
+	b.staged = nil
+}
+
+// WriteFileAtomic replaces a file through a synced temporary file and a rename,
+// keeping its mode like Stage. Targets that are not regular files, like
+// /dev/stdout, are written in place.
+func WriteFileAtomic(filename string, content []byte) error {
+	if info, err := os.Stat(filename); err == nil && !info.Mode().IsRegular() {
+		return os.WriteFile(filename, content, 0600)
+	}
+
+	batch := NewBatch()
+	if err := batch.Stage(filename, content); err != nil {
+		return err
+	}
+	return batch.Commit("")
+}
+
+// resolveTarget follows the symlinks of an existing target to the file they point to
+func resolveTarget(target string) (string, error) {
+	info, err := os.Lstat(target)
+	if err != nil || info.Mode()&os.ModeSymlink == 0 {
+		return target, nil
+	}
+
+	resolved, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve symlink %s: %v", target, err)
+	}
+	return resolved, nil
+}
+
+// writeTempFile writes content to a synced temporary file, which CreateTemp
+// opens as 0600 so it is never readable by others before getting perm
+func writeTempFile(dir, base string, content []byte, perm os.FileMode) (string, error) {
+	file, err := os.CreateTemp(dir, "."+base+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+
+	name := file.Name()
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		os.Remove(name)
+		return "", err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		os.Remove(name)
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(name)
+		return "", err
+	}
+	if err := os.Chmod(name, perm); err != nil {
+		os.Remove(name)
+		return "", err
+	}
+
+	return name, nil
+}
+
+// backupFile copies target into backupDir keeping its path relative to the
+// working directory, the project root
+func backupFile(target, backupDir string) error {
+	name, err := backupName(target)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(target)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open %s for backup: %v", target, err)
+	}
+	defer src.Close()
+
+	dest := filepath.Join(backupDir, name)
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %v", err)
+	}
+
+	dst, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup of %s: %v", target, err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("failed to back up %s: %v", target, err)
+	}
+	return dst.Sync()
+}
+
+// backupName returns the path of target relative to the working directory,
+// refusing targets outside of it since their backup would escape backupDir
+func backupName(target string) (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(cwd, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("cannot back up %s, it is outside the project directory", target)
+	}
+	return rel, nil
+}
@@ -0,0 +1,276 @@
+package envfile
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BindError describes a variable that could not be bound to its field
+type BindError struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+func (e BindError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+// BindErrors aggregates every variable that could not be bound
+type BindErrors []BindError
+
+func (e BindErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d invalid variables:\n  %s", len(e), strings.Join(messages, "\n  "))
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	urlType             = reflect.TypeOf(url.URL{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Bind sets the fields of the struct dst points to from the variables named
+// by their env tags:
+//
+//	type Config struct {
+//		Port    int           `env:"PORT" envDefault:"8080"`
+//		Debug   bool          `env:"DEBUG"`
+//		Timeout time.Duration `env:"TIMEOUT" envDefault:"5s"`
+//		Hosts   []string      `env:"HOSTS" envSeparator:";"`
+//		DB      *url.URL      `env:"DATABASE_URL,required"`
+//	}
+//
+// Empty variables count as unset: the field gets its envDefault, or is left
+// untouched. Slices split values on commas unless envSeparator says
+// otherwise. Untagged struct fields are bound recursively. Every invalid
+// variable is reported in the returned BindErrors, without echoing values.
+func Bind(vars map[string]interface{}, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind target must be a pointer to a struct, got %T", dst)
+	}
+
+	var errs BindErrors
+	bindStruct(vars, v.Elem(), &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func bindStruct(vars map[string]interface{}, v reflect.Value, errs *BindErrors) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			if nested := v.Field(i); nested.Kind() == reflect.Struct && !isScalar(nested.Type()) {
+				bindStruct(vars, nested, errs)
+			}
+			continue
+		}
+
+		key, options, _ := strings.Cut(tag, ",")
+		if key == "" || key == "-" {
+			continue
+		}
+		required := options == "required"
+
+		value := formatValue(vars[key])
+		if value == "" {
+			value = field.Tag.Get("envDefault")
+		}
+		if value == "" {
+			if required {
+				*errs = append(*errs, BindError{Key: key, Message: "is required"})
+			}
+			continue
+		}
+
+		separator := ","
+		if sep, ok := field.Tag.Lookup("envSeparator"); ok && sep != "" {
+			separator = sep
+		}
+
+		if msg := setField(v.Field(i), value, separator); msg != "" {
+			*errs = append(*errs, BindError{Key: key, Message: msg})
+		}
+	}
+}
+
+// setField parses value into field, returning why it could not be parsed
+func setField(field reflect.Value, value, separator string) string {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		parts := strings.Split(value, separator)
+		slice := reflect.MakeSlice(field.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if msg := setScalar(slice.Index(i), strings.TrimSpace(part)); msg != "" {
+				return fmt.Sprintf("item %d %s", i+1, msg)
+			}
+		}
+		field.Set(slice)
+		return ""
+	}
+	return setScalar(field, value)
+}
+
+// setScalar parses value into a field holding a single value, allocating pointers
+func setScalar(field reflect.Value, value string) string {
+	if field.Kind() == reflect.Pointer {
+		elem := reflect.New(field.Type().Elem())
+		if msg := setScalar(elem.Elem(), value); msg != "" {
+			return msg
+		}
+		field.Set(elem)
+		return ""
+	}
+
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		if err := field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			return "has an invalid format"
+		}
+		return ""
+	}
+
+	switch field.Type() {
+	case durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return "must be a duration like 30s or 5m"
+		}
+		field.SetInt(int64(d))
+		return ""
+	case urlType:
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || (u.Host == "" && u.Opaque == "") {
+			return "must be an absolute url"
+		}
+		field.Set(reflect.ValueOf(*u))
+		return ""
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "must be a boolean"
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return "must be an integer"
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return "must be a positive integer"
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return "must be a number"
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Sprintf("has unsupported type %s", field.Type())
+	}
+	return ""
+}
+
+// formatValue returns the text of a variable. Floats decoded from JSON or
+// YAML are written without exponent, so 1000000 still binds to an int.
+func formatValue(raw interface{}) string {
+	switch v := raw.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// isScalar reports whether a struct type is bound from a single variable
+func isScalar(t reflect.Type) bool {
+	return t == urlType || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// Values gives typed access to the variables of a parsed environment
+type Values map[string]interface{}
+
+// String returns the variable as a string, empty when unset
+func (v Values) String(key string) string {
+	return formatValue(v[key])
+}
+
+// Int returns the variable as an integer, fallback when unset
+func (v Values) Int(key string, fallback int) (int, error) {
+	return lookupValue(v, key, fallback)
+}
+
+// Bool returns the variable as a boolean, fallback when unset
+func (v Values) Bool(key string, fallback bool) (bool, error) {
+	return lookupValue(v, key, fallback)
+}
+
+// Duration returns the variable as a duration, fallback when unset
+func (v Values) Duration(key string, fallback time.Duration) (time.Duration, error) {
+	return lookupValue(v, key, fallback)
+}
+
+// URL returns the variable as an absolute url, nil when unset
+func (v Values) URL(key string) (*url.URL, error) {
+	return lookupValue[*url.URL](v, key, nil)
+}
+
+// Strings returns the variable split on commas, nil when unset
+func (v Values) Strings(key string) []string {
+	value := v.String(key)
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	return parts
+}
+
+// Bind binds the variables into the struct dst points to, see Bind
+func (v Values) Bind(dst interface{}) error {
+	return Bind(v, dst)
+}
+
+func lookupValue[T any](v Values, key string, fallback T) (T, error) {
+	value := v.String(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	var result T
+	if msg := setScalar(reflect.ValueOf(&result).Elem(), value); msg != "" {
+		return fallback, BindError{Key: key, Message: msg}
+	}
+	return result, nil
+}
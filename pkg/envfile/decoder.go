@@ -0,0 +1,241 @@
+package envfile
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// Decoder represents a format environments can be imported from.
+// Decoded values may be nested maps and slices, see Flatten.
+type Decoder interface {
+	Decode(r io.Reader) (interface{}, error)
+}
+
+// DecoderFunc adapts a function to the Decoder interface
+type DecoderFunc func(r io.Reader) (interface{}, error)
+
+// Decode calls f(r)
+func (f DecoderFunc) Decode(r io.Reader) (interface{}, error) {
+	return f(r)
+}
+
+var decoders = map[string]Decoder{}
+
+// RegisterDecoder makes a decoder available under the given format name
+func RegisterDecoder(format string, decoder Decoder) {
+	decoders[format] = decoder
+}
+
+// GetDecoder returns the decoder registered for the format
+func GetDecoder(format string) (Decoder, error) {
+	decoder, ok := decoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q, expected one of: %s", format, strings.Join(DecoderFormats(), ", "))
+	}
+	return decoder, nil
+}
+
+// DecoderFormats returns the registered format names in alphabetical order
+func DecoderFormats() []string {
+	formats := make([]string, 0, len(decoders))
+	for name := range decoders {
+		formats = append(formats, name)
+	}
+	slices.Sort(formats)
+	return formats
+}
+
+func init() {
+	RegisterDecoder("dotenv", DecoderFunc(decodeDotenv))
+	RegisterDecoder("json", DecoderFunc(decodeJSON))
+	RegisterDecoder("yaml", DecoderFunc(decodeYAML))
+	RegisterDecoder("properties", DecoderFunc(decodeProperties))
+}
+
+// DecodeEnv decodes r with the format's decoder and flattens the result
+func DecodeEnv(format string, r io.Reader, separator string) (map[string]interface{}, error) {
+	decoder, err := GetDecoder(format)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decoder.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", format, err)
+	}
+
+	return Flatten(value, separator)
+}
+
+// Flatten turns nested maps and slices into variables whose names join the
+// path with the separator, e.g. {"db": {"host": "x"}} becomes db__host=x
+func Flatten(value interface{}, separator string) (map[string]interface{}, error) {
+	root, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a mapping of variables at the top level")
+	}
+
+	vars := make(map[string]interface{})
+	for k, v := range root {
+		if err := flattenInto(vars, k, v, separator); err != nil {
+			return nil, err
+		}
+	}
+	return vars, nil
+}
+
+func flattenInto(vars map[string]interface{}, key string, value interface{}, separator string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if err := flattenInto(vars, key+separator+k, child, separator); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, child := range v {
+			if err := flattenInto(vars, key+separator+strconv.Itoa(i), child, separator); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if _, exists := vars[key]; exists {
+		return fmt.Errorf("variable %s is defined more than once after flattening", key)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		vars[key] = ""
+	case string:
+		vars[key] = v
+	default:
+		vars[key] = fmt.Sprintf("%v", v)
+	}
+	return nil
+}
+
+func decodeDotenv(r io.Reader) (interface{}, error) {
+	return NewReaderParser(r).Parse()
+}
+
+func decodeJSON(r io.Reader) (interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func decodeYAML(r io.Reader) (interface{}, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Unmarshal(data)
+}
+
+// decodeProperties reads Java properties. Keys are kept flat as written, so
+// both a and a.b can be set like in any properties file, and a later
+// definition of a key replaces the earlier one.
+func decodeProperties(r io.Reader) (interface{}, error) {
+	root := map[string]interface{}{}
+	scanner := bufio.NewScanner(r)
+
+	var logical strings.Builder
+	for scanner.Scan() {
+		text := strings.TrimLeft(scanner.Text(), " \t\f")
+		if logical.Len() == 0 && (text == "" || text[0] == '#' || text[0] == '!') {
+			continue
+		}
+
+		// An odd number of trailing backslashes continues the line
+		trailing := len(text) - len(strings.TrimRight(text, `\`))
+		if trailing%2 == 1 {
+			logical.WriteString(text[:len(text)-1])
+			continue
+		}
+		logical.WriteString(text)
+
+		key, value := splitProperty(logical.String())
+		logical.Reset()
+		root[unescapeProperty(key)] = unescapeProperty(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if logical.Len() > 0 {
+		key, value := splitProperty(logical.String())
+		root[unescapeProperty(key)] = unescapeProperty(value)
+	}
+
+	return root, nil
+}
+
+// splitProperty splits at the first unescaped '=', ':' or whitespace
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+				rest = rest[1:]
+			}
+			return line[:i], strings.TrimLeft(rest, " \t\f")
+		}
+	}
+	return line, ""
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					sb.WriteRune(rune(r))
+					i += 4
+					continue
+				}
+			}
+			sb.WriteByte('u')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
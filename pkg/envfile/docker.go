@@ -0,0 +1,71 @@
+package envfile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// DefaultComposeService is the service name used by the registered compose encoder
+const DefaultComposeService = "app"
+
+func init() {
+	RegisterEncoder("docker-env", EncoderFunc(encodeDockerEnv))
+	RegisterEncoder("compose", NewComposeEncoder(DefaultComposeService))
+}
+
+// encodeDockerEnv writes Docker's --env-file format, where everything after
+// the first '=' is taken literally: there are no quotes, escapes or multiline values
+func encodeDockerEnv(w io.Writer, vars map[string]interface{}) error {
+	for _, k := range sortedKeys(vars) {
+		if k == "" || strings.ContainsAny(k, " \t\n\r\v\f=") || strings.HasPrefix(k, "#") {
+			return fmt.Errorf("variable %q cannot be represented in a docker env file", k)
+		}
+
+		value := fmt.Sprintf("%v", vars[k])
+		if strings.ContainsAny(value, "\n\r\x00") {
+			return fmt.Errorf("value of %s contains a line break or NUL, which docker env files cannot represent", k)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s=%s\n", k, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ComposeEncoder writes an environment as the environment mapping of a compose service
+type ComposeEncoder struct {
+	Service string
+}
+
+// NewComposeEncoder creates a compose encoder for the named service
+func NewComposeEncoder(service string) *ComposeEncoder {
+	return &ComposeEncoder{Service: service}
+}
+
+// Encode writes the services.<service>.environment mapping.
+// Dollar signs are doubled so compose does not interpolate them.
+func (e *ComposeEncoder) Encode(w io.Writer, vars map[string]interface{}) error {
+	environment := make(map[string]string, len(vars))
+	for k, v := range vars {
+		environment[k] = strings.ReplaceAll(fmt.Sprintf("%v", v), "$", "$$")
+	}
+
+	doc := map[string]interface{}{
+		"services": map[string]interface{}{
+			e.Service: map[string]interface{}{
+				"environment": environment,
+			},
+		},
+	}
+
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,188 @@
+package envfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// Encoder represents a format environments can be exported to
+type Encoder interface {
+	Encode(w io.Writer, vars map[string]interface{}) error
+}
+
+// EncoderFunc adapts a function to the Encoder interface
+type EncoderFunc func(w io.Writer, vars map[string]interface{}) error
+
+// Encode calls f(w, vars)
+func (f EncoderFunc) Encode(w io.Writer, vars map[string]interface{}) error {
+	return f(w, vars)
+}
+
+var encoders = map[string]Encoder{}
+
+// RegisterEncoder makes an encoder available under the given format name
+func RegisterEncoder(format string, encoder Encoder) {
+	encoders[format] = encoder
+}
+
+// GetEncoder returns the encoder registered for the format
+func GetEncoder(format string) (Encoder, error) {
+	encoder, ok := encoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q, expected one of: %s", format, strings.Join(EncoderFormats(), ", "))
+	}
+	return encoder, nil
+}
+
+// EncoderFormats returns the registered format names in alphabetical order
+func EncoderFormats() []string {
+	formats := make([]string, 0, len(encoders))
+	for name := range encoders {
+		formats = append(formats, name)
+	}
+	slices.Sort(formats)
+	return formats
+}
+
+func init() {
+	RegisterEncoder("dotenv", EncoderFunc(encodeDotenv))
+	RegisterEncoder("json", EncoderFunc(encodeJSON))
+	RegisterEncoder("yaml", EncoderFunc(encodeYAML))
+	RegisterEncoder("toml", EncoderFunc(encodeTOML))
+	RegisterEncoder("shell", EncoderFunc(encodeShell))
+	RegisterEncoder("fish", EncoderFunc(encodeFish))
+	RegisterEncoder("powershell", EncoderFunc(encodePowerShell))
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// sortedKeys returns the variable names in alphabetical order
+func sortedKeys(vars map[string]interface{}) []string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// stringValues converts every value to its string form
+func stringValues(vars map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(vars))
+	for k, v := range vars {
+		values[k] = fmt.Sprintf("%v", v)
+	}
+	return values
+}
+
+func encodeDotenv(w io.Writer, vars map[string]interface{}) error {
+	_, err := w.Write(Marshal(vars))
+	return err
+}
+
+func encodeJSON(w io.Writer, vars map[string]interface{}) error {
+	data, err := json.MarshalIndent(stringValues(vars), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func encodeYAML(w io.Writer, vars map[string]interface{}) error {
+	data, err := yaml.Marshal(stringValues(vars))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+var tomlBareKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func encodeTOML(w io.Writer, vars map[string]interface{}) error {
+	for _, k := range sortedKeys(vars) {
+		key := k
+		if !tomlBareKeyPattern.MatchString(k) {
+			key = tomlString(k)
+		}
+		if _, err := fmt.Fprintf(w, "%s = %s\n", key, tomlString(fmt.Sprintf("%v", vars[k]))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tomlString quotes a value as a TOML basic string
+func tomlString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func encodeShell(w io.Writer, vars map[string]interface{}) error {
+	for _, k := range sortedKeys(vars) {
+		if !identifierPattern.MatchString(k) {
+			return fmt.Errorf("variable %s is not a valid shell identifier", k)
+		}
+		value := strings.ReplaceAll(fmt.Sprintf("%v", vars[k]), `'`, `'\''`)
+		if _, err := fmt.Fprintf(w, "export %s='%s'\n", k, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeFish(w io.Writer, vars map[string]interface{}) error {
+	for _, k := range sortedKeys(vars) {
+		if !identifierPattern.MatchString(k) {
+			return fmt.Errorf("variable %s is not a valid fish variable name", k)
+		}
+		value := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(fmt.Sprintf("%v", vars[k]))
+		if _, err := fmt.Fprintf(w, "set -gx %s '%s'\n", k, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodePowerShell(w io.Writer, vars map[string]interface{}) error {
+	for _, k := range sortedKeys(vars) {
+		name := "$env:" + k
+		if !identifierPattern.MatchString(k) {
+			name = "${env:" + strings.NewReplacer("`", "``", "}", "`}").Replace(k) + "}"
+		}
+		value := strings.ReplaceAll(fmt.Sprintf("%v", vars[k]), `'`, `''`)
+		if _, err := fmt.Fprintf(w, "%s = '%s'\n", name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,14 +3,15 @@ package envfile
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
-	"slices"
 	"strings"
 )
 
 // Parser represents the environment file parser
 type Parser struct {
 	filename string
+	reader   io.Reader
 }
 
 // NewParser creates a new environment file parser
@@ -20,16 +21,27 @@ func NewParser(filename string) *Parser {
 	}
 }
 
+// NewReaderParser creates a parser for environment contents read from r
+func NewReaderParser(r io.Reader) *Parser {
+	return &Parser{
+		reader: r,
+	}
+}
+
 // Parse reads and parses an environment file, returning a map of key-value pairs
 func (p *Parser) Parse() (map[string]interface{}, error) {
-	file, err := os.Open(p.filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open environment file %s: %v", p.filename, err)
+	reader := p.reader
+	if reader == nil {
+		file, err := os.Open(p.filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open environment file %s: %w", p.filename, err)
+		}
+		defer file.Close()
+		reader = file
 	}
-	defer file.Close()
 
 	vars := make(map[string]interface{})
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(reader)
 
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -82,29 +94,22 @@ func NewWriter(filename string) *Writer {
 	}
 }
 
-// Write writes the environment variables to a file
+// Write atomically replaces the file with the environment variables
 func (w *Writer) Write(vars map[string]interface{}) error {
-	file, err := os.Create(w.filename)
-	if err != nil {
-		return fmt.Errorf("failed to create environment file %s: %v", w.filename, err)
+	if err := WriteFileAtomic(w.filename, Marshal(vars)); err != nil {
+		return fmt.Errorf("failed to write environment file %s: %v", w.filename, err)
 	}
-	defer file.Close()
+	return nil
+}
 
+// Marshal formats the environment variables as sorted KEY=value lines
+func Marshal(vars map[string]interface{}) []byte {
 	// Sort keys for consistent output
-	keys := make([]string, 0, len(vars))
-	for k := range vars {
-		keys = append(keys, k)
-	}
-	slices.Sort(keys)
-
-	// Write sorted key-value pairs
-	for _, k := range keys {
-		if _, err := fmt.Fprintf(file, "%s=%v\n", k, vars[k]); err != nil {
-			return fmt.Errorf("failed to write to environment file: %v", err)
-		}
+	var sb strings.Builder
+	for _, k := range sortedKeys(vars) {
+		fmt.Fprintf(&sb, "%s=%v\n", k, vars[k])
 	}
-
-	return nil
+	return []byte(sb.String())
 }
 
 func WriteEnvFile(fileName string, vars map[string]interface{}) error {
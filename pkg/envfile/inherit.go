@@ -0,0 +1,88 @@
+package envfile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Origin tells where the value of a variable comes from after inheritance
+type Origin struct {
+	// Env is the environment that defines the value
+	Env string
+	// Overrides is the ancestor whose value is overridden, if any
+	Overrides string
+	// HasOverride is true when Overrides is set, since the default environment is named ""
+	HasOverride bool
+}
+
+// ResolveInheritance returns every environment with the variables of its
+// ancestors merged in, the closest definition winning. parents maps an
+// environment to the one it extends.
+func ResolveInheritance(envs map[string]map[string]interface{}, parents map[string]string) (map[string]map[string]interface{}, error) {
+	resolved := make(map[string]map[string]interface{}, len(envs))
+	for envName := range envs {
+		chain, err := Ancestors(envName, parents)
+		if err != nil {
+			return nil, err
+		}
+
+		vars := make(map[string]interface{})
+		// Apply the farthest ancestor first so closer ones override it
+		for i := len(chain) - 1; i >= 0; i-- {
+			for k, v := range envs[chain[i]] {
+				vars[k] = v
+			}
+		}
+		for k, v := range envs[envName] {
+			vars[k] = v
+		}
+		resolved[envName] = vars
+	}
+	return resolved, nil
+}
+
+// Ancestors returns the parents of an environment, closest first
+func Ancestors(envName string, parents map[string]string) ([]string, error) {
+	var chain []string
+	seen := map[string]bool{envName: true}
+
+	current := envName
+	for {
+		parent, ok := parents[current]
+		if !ok {
+			return chain, nil
+		}
+		if seen[parent] {
+			names := append([]string{envName}, chain...)
+			names = append(names, parent)
+			for i, name := range names {
+				names[i] = DisplayName(name)
+			}
+			return nil, fmt.Errorf("inheritance cycle: %s", strings.Join(names, " -> "))
+		}
+		seen[parent] = true
+		chain = append(chain, parent)
+		current = parent
+	}
+}
+
+// Origins returns where each variable of the resolved environment comes from
+func Origins(envName string, envs map[string]map[string]interface{}, parents map[string]string) (map[string]Origin, error) {
+	chain, err := Ancestors(envName, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := make(map[string]Origin)
+	for i := len(chain) - 1; i >= 0; i-- {
+		for k := range envs[chain[i]] {
+			prev, exists := origins[k]
+			origins[k] = Origin{Env: chain[i], Overrides: prev.Env, HasOverride: exists}
+		}
+	}
+	for k := range envs[envName] {
+		prev, exists := origins[k]
+		origins[k] = Origin{Env: envName, Overrides: prev.Env, HasOverride: exists}
+	}
+	return origins, nil
+}
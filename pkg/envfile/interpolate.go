@@ -0,0 +1,206 @@
+package envfile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LookupFunc finds a variable outside of the environments, like os.LookupEnv
+type LookupFunc func(key string) (string, bool)
+
+// Expander resolves ${VAR}, ${VAR:-default} and ${env:other.VAR} references
+// between the variables of a set of environments. $${ is an escaped, literal ${.
+type Expander struct {
+	envs   map[string]map[string]interface{}
+	lookup LookupFunc
+
+	resolved map[reference]string
+	visiting map[reference]bool
+	stack    []reference
+}
+
+type reference struct {
+	env string
+	key string
+}
+
+func (r reference) String() string {
+	if r.env == "" {
+		return "default." + r.key
+	}
+	return r.env + "." + r.key
+}
+
+// NewExpander creates an expander over the environments.
+// Same-environment references missing from the environment fall back to lookup, when set.
+func NewExpander(envs map[string]map[string]interface{}, lookup LookupFunc) *Expander {
+	return &Expander{
+		envs:     envs,
+		lookup:   lookup,
+		resolved: make(map[reference]string),
+		visiting: make(map[reference]bool),
+	}
+}
+
+// Env returns the variables of an environment with every reference expanded
+func (e *Expander) Env(envName string) (map[string]interface{}, error) {
+	vars, ok := e.envs[envName]
+	if !ok {
+		return nil, fmt.Errorf("environment %s not found", DisplayName(envName))
+	}
+
+	result := make(map[string]interface{}, len(vars))
+	for _, key := range sortedKeys(vars) {
+		value, _, err := e.value(reference{env: envName, key: key})
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// value resolves a single variable, reporting whether it exists
+func (e *Expander) value(ref reference) (string, bool, error) {
+	if value, ok := e.resolved[ref]; ok {
+		return value, true, nil
+	}
+
+	raw, ok := e.envs[ref.env][ref.key]
+	if !ok {
+		return "", false, nil
+	}
+
+	if e.visiting[ref] {
+		chain := make([]string, 0, len(e.stack)+1)
+		for _, r := range e.stack[indexOf(e.stack, ref):] {
+			chain = append(chain, r.String())
+		}
+		chain = append(chain, ref.String())
+		return "", false, fmt.Errorf("reference cycle: %s", strings.Join(chain, " -> "))
+	}
+
+	e.visiting[ref] = true
+	e.stack = append(e.stack, ref)
+	value, err := e.expand(ref.env, fmt.Sprintf("%v", raw))
+	e.stack = e.stack[:len(e.stack)-1]
+	delete(e.visiting, ref)
+	if err != nil {
+		return "", false, err
+	}
+
+	e.resolved[ref] = value
+	return value, true, nil
+}
+
+// expand replaces every reference in s, read from the given environment
+func (e *Expander) expand(envName, s string) (string, error) {
+	var sb strings.Builder
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			sb.WriteString(s)
+			return sb.String(), nil
+		}
+
+		if start > 0 && s[start-1] == '$' {
+			sb.WriteString(s[:start-1])
+			sb.WriteString("${")
+			s = s[start+2:]
+			continue
+		}
+
+		// An unterminated ${ is kept as text, values like passwords may hold one
+		end := matchingBrace(s, start+2)
+		if end < 0 {
+			sb.WriteString(s[:start+2])
+			s = s[start+2:]
+			continue
+		}
+
+		sb.WriteString(s[:start])
+		value, err := e.resolve(envName, s[start+2:end])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(value)
+		s = s[end+1:]
+	}
+}
+
+// resolve evaluates the body of a ${...} reference
+func (e *Expander) resolve(envName, body string) (string, error) {
+	name, fallback, hasDefault := strings.Cut(body, ":-")
+
+	ref := reference{env: envName, key: name}
+	crossEnv := false
+	if other, ok := strings.CutPrefix(name, "env:"); ok {
+		otherEnv, key, found := strings.Cut(other, ".")
+		if !found || key == "" {
+			return "", fmt.Errorf("invalid reference ${%s}, expected ${env:<environment>.<VAR>}", body)
+		}
+		if otherEnv == "default" {
+			otherEnv = ""
+		}
+		ref = reference{env: otherEnv, key: key}
+		crossEnv = true
+	}
+	if ref.key == "" {
+		return "", fmt.Errorf("invalid empty reference ${%s}", body)
+	}
+
+	value, found, err := e.value(ref)
+	if err != nil {
+		return "", err
+	}
+	if !found && !crossEnv && e.lookup != nil {
+		value, found = e.lookup(ref.key)
+	}
+
+	if hasDefault && value == "" {
+		return e.expand(envName, fallback)
+	}
+	if !found {
+		return "", fmt.Errorf("undefined variable %s", ref)
+	}
+	return value, nil
+}
+
+// matchingBrace returns the index of the '}' closing a reference whose body starts at from
+func matchingBrace(s string, from int) int {
+	depth := 1
+	for i := from; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "$${"):
+			// Escaped, does not open a reference
+			i += 2
+		case s[i] == '$' && i+1 < len(s) && s[i+1] == '{':
+			depth++
+			i++
+		case s[i] == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func indexOf(stack []reference, ref reference) int {
+	for i, r := range stack {
+		if r == ref {
+			return i
+		}
+	}
+	return 0
+}
+
+// DisplayName returns the name of an environment as shown to users, which
+// is "default" for the default environment
+func DisplayName(envName string) string {
+	if envName == "" {
+		return "default"
+	}
+	return envName
+}
@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// Kind represents the type of manifest rendered from an environment
+type Kind string
+
+const (
+	Secret    Kind = "Secret"
+	ConfigMap Kind = "ConfigMap"
+)
+
+// ParseKind validates a manifest kind given by the user
+func ParseKind(name string) (Kind, error) {
+	switch strings.ToLower(name) {
+	case "secret":
+		return Secret, nil
+	case "configmap":
+		return ConfigMap, nil
+	}
+	return "", fmt.Errorf("unknown kind %q, expected secret or configmap", name)
+}
+
+// Metadata is the object metadata of a manifest
+type Metadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is a Secret or ConfigMap holding environment variables
+type Manifest struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       Kind              `json:"kind"`
+	Metadata   Metadata          `json:"metadata"`
+	Type       string            `json:"type,omitempty"`
+	Data       map[string]string `json:"data"`
+}
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	keyPattern  = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+)
+
+// NewManifest builds a manifest of the given kind from environment variables.
+// Secret values are base64 encoded as required by the data field.
+func NewManifest(kind Kind, meta Metadata, vars map[string]interface{}) (*Manifest, error) {
+	if len(meta.Name) > 253 || !namePattern.MatchString(meta.Name) {
+		return nil, fmt.Errorf("invalid name %q, it must be a lowercase DNS subdomain", meta.Name)
+	}
+	if meta.Namespace != "" && (len(meta.Namespace) > 63 || strings.Contains(meta.Namespace, ".") || !namePattern.MatchString(meta.Namespace)) {
+		return nil, fmt.Errorf("invalid namespace %q, it must be a lowercase DNS label", meta.Namespace)
+	}
+
+	data := make(map[string]string, len(vars))
+	for k, v := range vars {
+		if !keyPattern.MatchString(k) {
+			return nil, fmt.Errorf("variable %s is not a valid %s key", k, kind)
+		}
+
+		value := fmt.Sprintf("%v", v)
+		if kind == Secret {
+			value = base64.StdEncoding.EncodeToString([]byte(value))
+		}
+		data[k] = value
+	}
+
+	manifest := &Manifest{
+		APIVersion: "v1",
+		Kind:       kind,
+		Metadata:   meta,
+		Data:       data,
+	}
+	if kind == Secret {
+		manifest.Type = "Opaque"
+	}
+	return manifest, nil
+}
+
+// Marshal renders the manifest as a YAML document
+func (m *Manifest) Marshal() ([]byte, error) {
+	return yaml.Marshal(m)
+}
+
+// ParsePairs parses key=value flags such as labels and annotations
+func ParsePairs(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid pair %q, expected key=value", pair)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
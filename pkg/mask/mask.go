@@ -0,0 +1,35 @@
+package mask
+
+import "fmt"
+
+// masked replaces every sensitive value, whatever its length
+const masked = "********"
+
+// Masker hides the values of sensitive variables in displayed output
+type Masker struct {
+	reveal      bool
+	isSensitive func(key string) bool
+}
+
+// New creates a masker. Every variable is sensitive when isSensitive is nil,
+// and reveal disables masking altogether.
+func New(reveal bool, isSensitive func(key string) bool) *Masker {
+	return &Masker{reveal: reveal, isSensitive: isSensitive}
+}
+
+// Value returns the value of a variable as it should be displayed
+func (m *Masker) Value(key string, value interface{}) string {
+	s := fmt.Sprintf("%v", value)
+	if m == nil || m.reveal || (m.isSensitive != nil && !m.isSensitive(key)) {
+		return s
+	}
+	return Mask(s)
+}
+
+// Mask hides a value entirely, only telling empty values apart
+func Mask(value string) string {
+	if value == "" {
+		return ""
+	}
+	return masked
+}
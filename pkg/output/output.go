@@ -0,0 +1,90 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// Format represents an output format for reported data
+type Format string
+
+const (
+	Table Format = "table"
+	JSON  Format = "json"
+	YAML  Format = "yaml"
+)
+
+// ParseFormat validates a format name given by the user
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(name)); f {
+	case Table, JSON, YAML:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown output format %q, expected table, json or yaml", name)
+}
+
+// Rows describes the tabular form of a value
+type Rows struct {
+	Headers []string
+	Values  [][]string
+	// Empty is printed in table mode when there are no values
+	Empty string
+}
+
+// Renderer represents a minimal interface for reporting data
+type Renderer interface {
+	Render(value interface{}, rows Rows) error
+}
+
+type renderer struct {
+	w      io.Writer
+	format Format
+}
+
+// New creates a renderer that writes values to w in the given format
+func New(w io.Writer, format Format) Renderer {
+	return &renderer{w: w, format: format}
+}
+
+// Render writes the value as JSON or YAML, or its rows as a table
+func (r *renderer) Render(value interface{}, rows Rows) error {
+	switch r.format {
+	case JSON:
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode json output: %v", err)
+		}
+		_, err = fmt.Fprintf(r.w, "%s\n", data)
+		return err
+	case YAML:
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode yaml output: %v", err)
+		}
+		_, err = r.w.Write(data)
+		return err
+	default:
+		return r.renderTable(rows)
+	}
+}
+
+func (r *renderer) renderTable(rows Rows) error {
+	if len(rows.Values) == 0 && rows.Empty != "" {
+		_, err := fmt.Fprintln(r.w, rows.Empty)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(r.w, 0, 0, 2, ' ', 0)
+	if len(rows.Headers) > 0 {
+		fmt.Fprintln(tw, strings.Join(rows.Headers, "\t"))
+	}
+	for _, row := range rows.Values {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
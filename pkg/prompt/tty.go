@@ -0,0 +1,12 @@
+package prompt
+
+import "os"
+
+// IsTerminal reports whether the given file is attached to an interactive terminal
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,319 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/yaml"
+)
+
+// Type represents the kind of value a variable holds
+type Type string
+
+const (
+	String Type = "string"
+	Int    Type = "int"
+	Number Type = "number"
+	Bool   Type = "bool"
+	URL    Type = "url"
+	Enum   Type = "enum"
+	Regex  Type = "regex"
+)
+
+// Rule describes the expected value of a variable
+type Rule struct {
+	Type        Type     `json:"type,omitempty"`
+	Required    *bool    `json:"required,omitempty"`
+	Values      []string `json:"values,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	Description string   `json:"description,omitempty"`
+	// Sensitive values are masked when displayed, which is the default
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// compiled is Pattern compiled by Parse
+	compiled *regexp.Regexp
+}
+
+// IsSensitive reports whether the value must be masked when displayed
+func (r Rule) IsSensitive() bool {
+	return r.Sensitive == nil || *r.Sensitive
+}
+
+// IsRequired reports whether the variable must be present and non-empty
+func (r Rule) IsRequired() bool {
+	return r.Required != nil && *r.Required
+}
+
+// DefaultEnvironment is the name the overrides of the default environment are
+// declared under, the environment itself is named "" everywhere else
+const DefaultEnvironment = "default"
+
+// Environment holds rule overrides for a single environment
+type Environment struct {
+	Variables map[string]Rule `json:"variables"`
+}
+
+// Schema declares the variables of an app and how to validate them
+type Schema struct {
+	Variables    map[string]Rule        `json:"variables"`
+	Environments map[string]Environment `json:"environments,omitempty"`
+}
+
+// FileNames are the schema files looked up inside the config directory, in order
+var FileNames = []string{"schema", "schema.json", "schema.yaml", "schema.yml"}
+
+// Load reads the schema from the config directory.
+// It returns nil without error when there is no schema file.
+func Load(dir string) (*Schema, error) {
+	for _, name := range FileNames {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read schema %s: %v", path, err)
+		}
+
+		s, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema %s: %v", path, err)
+		}
+		return s, nil
+	}
+	return nil, nil
+}
+
+// Parse decodes a JSON or YAML schema and checks its rules
+func Parse(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		// Not JSON, decode as YAML and go through JSON to reuse the struct tags
+		value, yamlErr := yaml.Unmarshal(data)
+		if yamlErr != nil {
+			return nil, yamlErr
+		}
+		converted, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(converted, &s); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.compilePatterns(); err != nil {
+		return nil, err
+	}
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// compilePatterns compiles the pattern of every rule once, so validating does not compile them again
+func (s *Schema) compilePatterns() error {
+	for key, rule := range s.Variables {
+		if err := rule.compilePattern(); err != nil {
+			return fmt.Errorf("variable %s: %v", key, err)
+		}
+		s.Variables[key] = rule
+	}
+	for env, e := range s.Environments {
+		for key, rule := range e.Variables {
+			if err := rule.compilePattern(); err != nil {
+				return fmt.Errorf("environment %s, variable %s: %v", env, key, err)
+			}
+			e.Variables[key] = rule
+		}
+	}
+	return nil
+}
+
+func (r *Rule) compilePattern() error {
+	if r.Pattern == "" {
+		return nil
+	}
+	compiled, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern: %v", err)
+	}
+	r.compiled = compiled
+	return nil
+}
+
+func (s *Schema) check() error {
+	for env := range s.Environments {
+		for key, rule := range s.RulesFor(env) {
+			if err := rule.check(); err != nil {
+				return fmt.Errorf("environment %s, variable %s: %v", env, key, err)
+			}
+		}
+	}
+	for key, rule := range s.Variables {
+		if err := rule.check(); err != nil {
+			return fmt.Errorf("variable %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func (r Rule) check() error {
+	switch r.Type {
+	case "", String, Int, Number, Bool, URL:
+	case Enum:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("enum type requires values")
+		}
+	case Regex:
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown type %q", r.Type)
+	}
+	return nil
+}
+
+// RulesFor returns the rules of an environment, applying its overrides
+// field by field on top of the base rules
+func (s *Schema) RulesFor(env string) map[string]Rule {
+	if env == "" {
+		env = DefaultEnvironment
+	}
+
+	rules := make(map[string]Rule, len(s.Variables))
+	for k, r := range s.Variables {
+		rules[k] = r
+	}
+
+	for k, override := range s.Environments[env].Variables {
+		rule := rules[k]
+		if override.Type != "" {
+			rule.Type = override.Type
+		}
+		if override.Required != nil {
+			rule.Required = override.Required
+		}
+		if override.Values != nil {
+			rule.Values = override.Values
+		}
+		if override.Pattern != "" {
+			rule.Pattern = override.Pattern
+			rule.compiled = override.compiled
+		}
+		if override.Description != "" {
+			rule.Description = override.Description
+		}
+		if override.Sensitive != nil {
+			rule.Sensitive = override.Sensitive
+		}
+		rules[k] = rule
+	}
+	return rules
+}
+
+// ValidationError describes an invalid variable
+type ValidationError struct {
+	Environment string `json:"environment"`
+	Key         string `json:"key"`
+	Message     string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+// ValidationErrors aggregates every invalid variable of a validation
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, fmt.Sprintf("[%s] %s", err.Environment, err.Error()))
+	}
+	return fmt.Sprintf("%d invalid variables:\n  %s", len(e), strings.Join(messages, "\n  "))
+}
+
+// Validate checks the variables of an environment, sorted by key
+func (s *Schema) Validate(env string, vars map[string]interface{}) ValidationErrors {
+	var errs ValidationErrors
+
+	rules := s.RulesFor(env)
+	keys := make([]string, 0, len(rules))
+	for k := range rules {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		rule := rules[key]
+		raw, exists := vars[key]
+		value := ""
+		if exists && raw != nil {
+			value = fmt.Sprintf("%v", raw)
+		}
+
+		if value == "" {
+			if rule.IsRequired() {
+				errs = append(errs, ValidationError{Environment: env, Key: key, Message: "is required"})
+			}
+			continue
+		}
+
+		if msg := rule.validateValue(value); msg != "" {
+			errs = append(errs, ValidationError{Environment: env, Key: key, Message: msg})
+		}
+	}
+
+	return errs
+}
+
+// validateValue returns why the value breaks the rule, without echoing the value
+func (r Rule) validateValue(value string) string {
+	switch r.Type {
+	case Int:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "must be an integer"
+		}
+	case Number:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	case Bool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "must be a boolean"
+		}
+	case URL:
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || (u.Host == "" && u.Opaque == "") {
+			return "must be an absolute url"
+		}
+	case Enum:
+		if !slices.Contains(r.Values, value) {
+			return fmt.Sprintf("must be one of: %s", strings.Join(r.Values, ", "))
+		}
+	case Regex:
+		// Schemas built without Parse have no compiled pattern
+		compiled := r.compiled
+		if compiled == nil {
+			var err error
+			if compiled, err = regexp.Compile(r.Pattern); err != nil {
+				return "cannot be checked, the schema pattern is invalid"
+			}
+		}
+		if !compiled.MatchString(value) {
+			return fmt.Sprintf("must match %s", r.Pattern)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/auth"
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// loadAccess returns the access of the authenticated user to an app, so
+// operations outside it are refused with a clear message before reaching the
+// server. Only a server answering that the user has no access to the app
+// fails here. When the access is unknown, because the login holds no user
+// information or the user is not listed individually, like members granted
+// access through a team, the server is left to enforce it.
+func loadAccess(ctx context.Context, c client.Client, fullAppName string) (client.Access, error) {
+	unknown := client.Access{}
+
+	authData, err := auth.Load()
+	if err != nil || !authData.HasUserInfo() {
+		return unknown, nil
+	}
+
+	users, err := c.ListAppUsers(ctx, fullAppName)
+	if err != nil {
+		var clientErr *client.ClientError
+		if errors.As(err, &clientErr) && slices.Contains([]int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound}, clientErr.Status) {
+			return client.Access{}, fmt.Errorf("no access to app %s: %v", fullAppName, err)
+		}
+		return unknown, nil
+	}
+
+	for _, user := range users {
+		if user.Username == authData.User.Username {
+			return user.Access(), nil
+		}
+	}
+	return unknown, nil
+}
+
+// checkWriteAccess loads the access of the authenticated user to an app and
+// fails unless it can write the targeted environment, or some environment
+// when none is targeted
+func checkWriteAccess(ctx context.Context, c client.Client, fullAppName string, targetEnv *string) (client.Access, error) {
+	access, err := loadAccess(ctx, c, fullAppName)
+	if err != nil {
+		return client.Access{}, err
+	}
+	if !access.CanWrite() {
+		return client.Access{}, fmt.Errorf("your role in app %s is %s, which cannot change environments", fullAppName, access.Role)
+	}
+	if err := checkTargetAccess(targetEnv, access); err != nil {
+		return client.Access{}, err
+	}
+	return access, nil
+}
+
+// accessScope describes the environments covered by an access
+func accessScope(access client.Access) string {
+	if len(access.Envs) == 0 {
+		return "all environments"
+	}
+
+	names := make([]string, len(access.Envs))
+	for i, envName := range access.Envs {
+		names[i] = envfile.DisplayName(envName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// checkTargetAccess fails when the targeted environment is outside the access scope
+func checkTargetAccess(targetEnv *string, access client.Access) error {
+	if targetEnv != nil && !access.Allows(*targetEnv) {
+		return fmt.Errorf("no access to environment %s, your access is limited to %s", envfile.DisplayName(*targetEnv), accessScope(access))
+	}
+	return nil
+}
+
+// filterByAccess drops the environments outside the access scope
+func filterByAccess(envs map[string]map[string]interface{}, access client.Access, logger logger.Logger) map[string]map[string]interface{} {
+	filtered := make(map[string]map[string]interface{}, len(envs))
+	for envName, vars := range envs {
+		if !access.Allows(envName) {
+			logger.Printf("skipping environment %s, your access is limited to %s", envfile.DisplayName(envName), accessScope(access))
+			continue
+		}
+		filtered[envName] = vars
+	}
+	return filtered
+}
@@ -14,6 +14,14 @@ import (
 // AddUserInput represents the input parameters for the add user operation
 type AddUserInput struct {
 	Username string
+	// Email invites someone by email, who may not have an account yet
+	Email string
+	// Team of the organization owning the app, granted access instead of a single user
+	Team string
+	// Role granted to the user, writer when empty
+	Role client.Role
+	// Envs limits the user to the given environments, all of them when empty
+	Envs []string
 }
 
 // AddUserFn represents a function that performs the add user operation
@@ -37,13 +45,67 @@ func NewAddUser(c client.Client, logger logger.Logger) AddUserFn {
 
 		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
 
-		logger.Printf("adding user %s to app %s", input.Username, fullAppName)
+		access := client.Access{Role: input.Role, Envs: input.Envs}
+		if access.Role == "" {
+			access.Role = client.RoleWriter
+		}
+		if access.Role == client.RoleOwner {
+			return fmt.Errorf("an app has a single owner, use app transfer to change it")
+		}
+
+		if input.Team != "" {
+			logger.Printf("adding team %s to app %s as %s of %s", input.Team, fullAppName, access.Role, accessScope(access))
+
+			if err := c.AddTeam(ctx, fullAppName, input.Team, access); err != nil {
+				return fmt.Errorf("failed to add team: %v", err)
+			}
+
+			logger.Printf("team %s successfully added to app %s", input.Team, fullAppName)
+			return nil
+		}
+
+		// Members get their access updated, anyone else has to accept an invite first
+		if input.Username != "" {
+			users, err := c.ListAppUsers(ctx, fullAppName)
+			if err != nil {
+				return fmt.Errorf("failed to list users: %v", err)
+			}
+			for _, user := range users {
+				if user.Username != input.Username {
+					continue
+				}
+				if user.IsOwner {
+					return fmt.Errorf("user %s owns app %s", input.Username, fullAppName)
+				}
 
-		if err := c.AddUser(ctx, fullAppName, input.Username); err != nil {
-			return fmt.Errorf("failed to add user: %v", err)
+				logger.Printf("updating access of user %s to app %s: %s of %s", input.Username, fullAppName, access.Role, accessScope(access))
+
+				if err := c.AddUser(ctx, fullAppName, input.Username, access); err != nil {
+					return fmt.Errorf("failed to update user: %v", err)
+				}
+
+				logger.Printf("access of user %s successfully updated", input.Username)
+				return nil
+			}
+		}
+
+		invitee := input.Username
+		if input.Email != "" {
+			invitee = input.Email
+		}
+		logger.Printf("inviting %s to app %s as %s of %s", invitee, fullAppName, access.Role, accessScope(access))
+
+		invite, err := c.CreateInvite(ctx, fullAppName, client.Invite{
+			Username: input.Username,
+			Email:    input.Email,
+			Role:     access.Role,
+			Envs:     access.Envs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to invite user: %v", err)
 		}
 
-		logger.Printf("user %s successfully added to app %s", input.Username, fullAppName)
+		logger.Printf("invite %s sent to %s, access is granted once they accept it with: env0 invites accept %s", invite.ID, invitee, invite.ID)
 		return nil
 	}
 }
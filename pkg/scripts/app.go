@@ -0,0 +1,218 @@
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/prompt"
+)
+
+// AppDeleteInput represents the input parameters for the app delete operation
+type AppDeleteInput struct {
+	FullAppName string
+	// Yes deletes without asking for confirmation
+	Yes         bool
+	Interactive bool
+	DryRun      bool
+}
+
+// AppDeleteFn represents a function that performs the app delete operation
+type AppDeleteFn func(context.Context, AppDeleteInput) error
+
+// NewAppDelete creates a new app delete function with injected dependencies
+func NewAppDelete(c client.Client, logger logger.Logger, reader prompt.Reader) AppDeleteFn {
+	return func(ctx context.Context, input AppDeleteInput) error {
+		if _, _, err := splitAppName(input.FullAppName); err != nil {
+			return err
+		}
+
+		cfg, err := localAppConfig(input.FullAppName)
+		if err != nil {
+			return err
+		}
+
+		if input.DryRun {
+			logger.Printf("would delete app %s and all of its environments", input.FullAppName)
+			if cfg != nil {
+				logger.Printf("would remove %s", filepath.Join(".env0", "config.json"))
+			}
+			return nil
+		}
+
+		ok, err := confirmAppChange(fmt.Sprintf("Delete app %s and all of its environments?", input.FullAppName), input.Yes, input.Interactive, logger, reader)
+		if err != nil || !ok {
+			return err
+		}
+
+		if err := c.DeleteApp(ctx, input.FullAppName); err != nil {
+			return fmt.Errorf("failed to delete app: %v", err)
+		}
+		logger.Printf("app %s deleted", input.FullAppName)
+
+		if cfg != nil {
+			if err := os.Remove(filepath.Join(".env0", "config.json")); err != nil {
+				return fmt.Errorf("failed to remove config file: %v", err)
+			}
+			logger.Printf("removed local config, env files were kept")
+		}
+		return nil
+	}
+}
+
+// AppRenameInput represents the input parameters for the app rename operation
+type AppRenameInput struct {
+	FullAppName string
+	NewName     string
+	DryRun      bool
+}
+
+// AppRenameFn represents a function that performs the app rename operation
+type AppRenameFn func(context.Context, AppRenameInput) error
+
+// NewAppRename creates a new app rename function with injected dependencies
+func NewAppRename(c client.Client, logger logger.Logger) AppRenameFn {
+	return func(ctx context.Context, input AppRenameInput) error {
+		owner, _, err := splitAppName(input.FullAppName)
+		if err != nil {
+			return err
+		}
+		if input.NewName == "" || strings.Contains(input.NewName, "/") {
+			return fmt.Errorf("invalid app name %q", input.NewName)
+		}
+		newFullAppName := fmt.Sprintf("%s/%s", owner, input.NewName)
+
+		cfg, err := localAppConfig(input.FullAppName)
+		if err != nil {
+			return err
+		}
+
+		if input.DryRun {
+			logger.Printf("would rename app %s to %s", input.FullAppName, newFullAppName)
+			if cfg != nil {
+				logger.Printf("would update %s", filepath.Join(".env0", "config.json"))
+			}
+			return nil
+		}
+
+		if err := c.RenameApp(ctx, input.FullAppName, input.NewName); err != nil {
+			return fmt.Errorf("failed to rename app: %v", err)
+		}
+		logger.Printf("app %s renamed to %s", input.FullAppName, newFullAppName)
+
+		if cfg != nil {
+			cfg.AppName = input.NewName
+			if err := writeConfigFile(cfg); err != nil {
+				return err
+			}
+			logger.Printf("local config now points to %s", newFullAppName)
+		}
+		return nil
+	}
+}
+
+// AppTransferInput represents the input parameters for the app transfer operation
+type AppTransferInput struct {
+	FullAppName string
+	Username    string
+	// Yes transfers without asking for confirmation
+	Yes         bool
+	Interactive bool
+	DryRun      bool
+}
+
+// AppTransferFn represents a function that performs the app transfer operation
+type AppTransferFn func(context.Context, AppTransferInput) error
+
+// NewAppTransfer creates a new app transfer function with injected dependencies
+func NewAppTransfer(c client.Client, logger logger.Logger, reader prompt.Reader) AppTransferFn {
+	return func(ctx context.Context, input AppTransferInput) error {
+		_, app, err := splitAppName(input.FullAppName)
+		if err != nil {
+			return err
+		}
+		newFullAppName := fmt.Sprintf("%s/%s", input.Username, app)
+
+		cfg, err := localAppConfig(input.FullAppName)
+		if err != nil {
+			return err
+		}
+
+		if input.DryRun {
+			logger.Printf("would transfer app %s to %s, renaming it to %s", input.FullAppName, input.Username, newFullAppName)
+			if cfg != nil {
+				logger.Printf("would update %s", filepath.Join(".env0", "config.json"))
+			}
+			return nil
+		}
+
+		ok, err := confirmAppChange(fmt.Sprintf("Transfer app %s to %s?", input.FullAppName, input.Username), input.Yes, input.Interactive, logger, reader)
+		if err != nil || !ok {
+			return err
+		}
+
+		if err := c.TransferOwnership(ctx, input.FullAppName, input.Username); err != nil {
+			return fmt.Errorf("failed to transfer app: %v", err)
+		}
+		logger.Printf("app %s transferred to %s, it is now %s", input.FullAppName, input.Username, newFullAppName)
+
+		if cfg != nil {
+			cfg.OwnerName = input.Username
+			if err := writeConfigFile(cfg); err != nil {
+				return err
+			}
+			logger.Printf("local config now points to %s", newFullAppName)
+		}
+		return nil
+	}
+}
+
+// splitAppName splits an owner/app name into its parts
+func splitAppName(fullAppName string) (owner, app string, err error) {
+	parts := strings.SplitN(fullAppName, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid app name %q, expected owner/app", fullAppName)
+	}
+	return parts[0], parts[1], nil
+}
+
+// localAppConfig returns the local config when the current directory holds the given app
+func localAppConfig(fullAppName string) (*config, error) {
+	if _, err := os.Stat(filepath.Join(".env0", "config.json")); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	cfg, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	if fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName) != fullAppName {
+		return nil, nil
+	}
+	return cfg, nil
+}
+
+// confirmAppChange asks before an irreversible change, unless it was accepted upfront
+func confirmAppChange(question string, yes, interactive bool, logger logger.Logger, reader prompt.Reader) (bool, error) {
+	if yes {
+		return true, nil
+	}
+	if !interactive {
+		return false, fmt.Errorf("confirmation required but stdin is not a terminal, use --yes")
+	}
+
+	ok, err := confirm(question, logger, reader)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		logger.Printf("cancelled")
+	}
+	return ok, nil
+}
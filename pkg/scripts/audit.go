@@ -0,0 +1,95 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+)
+
+// AuditInput represents the input parameters for the audit operation
+type AuditInput struct {
+	// EnvName only lists the events of an environment when set
+	EnvName *string
+	// Since is a duration like 7d or 12h, or a date like 2024-01-31
+	Since string
+	// User only lists the events of the given actor
+	User string
+}
+
+// AuditFn represents a function that performs the audit operation
+type AuditFn func(context.Context, AuditInput) error
+
+// NewAudit creates a new audit function with injected dependencies
+func NewAudit(c client.Client, logger logger.Logger, renderer output.Renderer) AuditFn {
+	return func(ctx context.Context, input AuditInput) error {
+		filter := client.AuditFilter{Env: input.EnvName, User: input.User}
+		if input.Since != "" {
+			since, err := parseSince(input.Since, time.Now())
+			if err != nil {
+				return err
+			}
+			filter.Since = since
+		}
+
+		cfg, err := readConfigFile()
+		if err != nil {
+			return err
+		}
+
+		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+		logger.Printf("listing audit events of app %s", fullAppName)
+
+		events, err := c.ListAuditEvents(ctx, fullAppName, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list audit events: %v", err)
+		}
+		if events == nil {
+			events = []client.AuditEvent{}
+		}
+
+		rows := output.Rows{
+			Headers: []string{"TIME", "ACTOR", "ACTION", "ENVIRONMENT", "DETAILS"},
+			Empty:   "no audit events found",
+		}
+		for _, event := range events {
+			env := "-"
+			if event.Environment != nil {
+				env = envfile.DisplayName(*event.Environment)
+			}
+			details := strings.Join(event.Keys, ", ")
+			if event.Target != "" {
+				details = event.Target
+			}
+			rows.Values = append(rows.Values, []string{event.CreatedAt, event.Actor, event.Action, env, details})
+		}
+
+		return renderer.Render(events, rows)
+	}
+}
+
+// parseSince converts a duration back from now, which besides Go durations
+// accepts days like 7d, or a date in YYYY-MM-DD or RFC 3339 format
+func parseSince(s string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q, expected a duration like 7d or 12h, or a date like 2024-01-31", s)
+}
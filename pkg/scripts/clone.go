@@ -3,16 +3,25 @@ package scripts
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
 	"github.com/Jibaru/env0/pkg/logger"
 )
 
 type CloneInput struct {
 	FullAppName string
+	// LayoutPattern and LayoutDefault set where the environment files are
+	// written, see layoutConfig, and are saved in the config
+	LayoutPattern string
+	LayoutDefault string
+	// DryRun reports the files that would be created without writing them
+	DryRun bool
 }
 
 // CloneFn represents a function that performs the clone operation
@@ -26,26 +35,52 @@ func NewClone(c client.Client, logger logger.Logger) CloneFn {
 			return fmt.Errorf("there is an app already cloned")
 		}
 
+		cfg := &config{}
+		if input.LayoutPattern != "" || input.LayoutDefault != "" {
+			cfg.Layout = &layoutConfig{Pattern: input.LayoutPattern, Default: input.LayoutDefault}
+		}
+		layout, err := cfg.layout()
+		if err != nil {
+			return err
+		}
+
 		// 2) Fetch envs from API
 		envs, err := c.GetApp(ctx, input.FullAppName)
 		if err != nil {
 			return err
 		}
 
-		// 3) Write .env files
-		for envName, vars := range envs {
-			fileName := fmt.Sprintf(".env.%s", envName)
-			file, err := os.Create(fileName)
-			if err != nil {
+		if input.DryRun {
+			for envName, vars := range envs {
+				logger.Printf("would write %s with %d variables", layout.fileName(envName), len(vars))
+			}
+			logger.Printf("would write %s", filepath.Join(".env0", "config.json"))
+			if err := ensureGitignore(true, logger); err != nil {
 				return err
 			}
-			for k, v := range vars {
-				fmt.Fprintf(file, "%s=%v\n", k, v)
+			logger.Printf("dry run: no files were written")
+			return nil
+		}
+
+		// 3) Keep the env files out of git before writing them
+		if err := ensureGitignore(false, logger); err != nil {
+			return err
+		}
+
+		// 4) Write .env files, all of them or none
+		batch := envfile.NewBatch()
+		for _, envName := range slices.Sorted(maps.Keys(envs)) {
+			fileName := layout.fileName(envName)
+			if err := batch.Stage(fileName, envfile.Marshal(envs[envName])); err != nil {
+				batch.Rollback()
+				return fmt.Errorf("failed to write env file %s: %v", fileName, err)
 			}
-			file.Close()
+		}
+		if err := batch.Commit(""); err != nil {
+			return err
 		}
 
-		// 4) Save local config
+		// 5) Save local config
 		parts := strings.SplitN(input.FullAppName, "/", 2)
 		owner := parts[0]
 		app := parts[1]
@@ -54,8 +89,9 @@ func NewClone(c client.Client, logger logger.Logger) CloneFn {
 			return err
 		}
 
-		cfgContent := fmt.Sprintf("{\"appName\": \"%s\", \"ownerName\": \"%s\"}", app, owner)
-		if err := os.WriteFile(filepath.Join(".env0", "config.json"), []byte(cfgContent), 0644); err != nil {
+		cfg.AppName = app
+		cfg.OwnerName = owner
+		if err := writeConfigFile(cfg); err != nil {
 			return err
 		}
 
@@ -2,8 +2,11 @@ package scripts
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Jibaru/env0/pkg/auth"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/prompt"
 )
 
 // LoadAndValidateToken loads and validates the authentication token
@@ -17,3 +20,21 @@ func LoadAndValidateToken() (string, error) {
 	}
 	return token, nil
 }
+
+// confirm asks a yes/no question, failing when no answer can be read
+func confirm(question string, logger logger.Logger, reader prompt.Reader) (bool, error) {
+	logger.Printf("%s [y/N]: ", question)
+
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read confirmation: %v", err)
+	}
+
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes", nil
+}
+
+// discardLogger drops every message, for helpers whose progress is not worth reporting
+type discardLogger struct{}
+
+func (discardLogger) Printf(format string, v ...interface{}) {}
@@ -6,6 +6,7 @@ import (
 	"github.com/Jibaru/env0/pkg/auth"
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 // ConfigPathInput represents the input parameters for the config path operation
@@ -13,18 +14,24 @@ type ConfigPathInput struct {
 	// Empty since we don't need any input parameters
 }
 
+// ConfigPathView is the reported location of the configuration directory
+type ConfigPathView struct {
+	Path string `json:"path"`
+}
+
 // ConfigPathFn represents a function that performs the config path operation
 type ConfigPathFn func(context.Context, ConfigPathInput) error
 
 // NewConfigPath creates a new config path function with injected dependencies
-func NewConfigPath(c client.Client, logger logger.Logger) ConfigPathFn {
+func NewConfigPath(c client.Client, logger logger.Logger, renderer output.Renderer) ConfigPathFn {
 	return func(ctx context.Context, input ConfigPathInput) error {
 		cfgPath, err := auth.GetConfigDir()
 		if err != nil {
 			return err
 		}
 
-		logger.Printf(cfgPath)
-		return nil
+		return renderer.Render(ConfigPathView{Path: cfgPath}, output.Rows{
+			Values: [][]string{{cfgPath}},
+		})
 	}
 }
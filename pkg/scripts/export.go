@@ -0,0 +1,146 @@
+package scripts
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// ExportInput represents the input parameters for the export operation
+type ExportInput struct {
+	EnvName string
+	Format  string
+	// Local reads the environment from its local env file instead of the remote app
+	Local bool
+	// File reads the environment from the given env file instead of the remote app
+	File string
+	// OutputPath writes the result to a file instead of the writer
+	OutputPath string
+	// Service names the compose service when exporting with the compose format
+	Service string
+	// Raw keeps ${...} references unexpanded
+	Raw bool
+}
+
+// ExportFn represents a function that performs the export operation
+type ExportFn func(context.Context, ExportInput) error
+
+// NewExport creates a new export function with injected dependencies
+func NewExport(c client.Client, logger logger.Logger, w io.Writer) ExportFn {
+	return func(ctx context.Context, input ExportInput) error {
+		encoder, err := envfile.GetEncoder(input.Format)
+		if err != nil {
+			return err
+		}
+		if input.Service != "" {
+			if input.Format != "compose" {
+				return fmt.Errorf("--service is only supported by the compose format")
+			}
+			encoder = envfile.NewComposeEncoder(input.Service)
+		}
+
+		load := loadResolvedEnvironment
+		if input.Raw {
+			load = loadEnvironment
+		}
+		vars, err := load(ctx, c, input.EnvName, input.Local, input.File, logger)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		if err := encoder.Encode(&buf, vars); err != nil {
+			return fmt.Errorf("failed to export environment as %s: %v", input.Format, err)
+		}
+
+		if input.OutputPath == "" {
+			_, err := w.Write(buf.Bytes())
+			return err
+		}
+
+		// The export holds secrets, so a new file is only readable by its owner
+		if err := envfile.WriteFileAtomic(input.OutputPath, buf.Bytes()); err != nil {
+			return fmt.Errorf("failed to write %s: %v", input.OutputPath, err)
+		}
+		logger.Printf("environment exported to %s", input.OutputPath)
+		return nil
+	}
+}
+
+// loadEnvironment reads an environment from an env file, its local env file
+// or the remote app of the initialized project, with inherited variables merged in
+func loadEnvironment(ctx context.Context, c client.Client, envName string, local bool, file string, logger logger.Logger) (map[string]interface{}, error) {
+	envs, err := loadEnvironmentSet(ctx, c, envName, local, file, logger)
+	if err != nil {
+		return nil, err
+	}
+	return envs[envName], nil
+}
+
+// loadResolvedEnvironment reads an environment like loadEnvironment and expands
+// its references, resolving other environments from the same source
+func loadResolvedEnvironment(ctx context.Context, c client.Client, envName string, local bool, file string, logger logger.Logger) (map[string]interface{}, error) {
+	envs, err := loadEnvironmentSet(ctx, c, envName, local, file, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := envfile.NewExpander(envs, os.LookupEnv).Env(envName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand environment %s: %v", envfile.DisplayName(envName), err)
+	}
+	return vars, nil
+}
+
+// loadEnvironmentSet reads every environment of the source holding envName,
+// the local env files or the remote app, and resolves their inheritance
+func loadEnvironmentSet(ctx context.Context, c client.Client, envName string, local bool, file string, logger logger.Logger) (map[string]map[string]interface{}, error) {
+	parents, err := readEnvParents()
+	if err != nil {
+		return nil, err
+	}
+
+	var envs map[string]map[string]interface{}
+	if file != "" || local {
+		layout, err := readLayout()
+		if err != nil {
+			return nil, err
+		}
+		envs, err = processEnvFiles(layout, nil, discardLogger{})
+		if err != nil {
+			return nil, err
+		}
+
+		source := cmp.Or(file, layout.fileName(envName))
+		vars, err := envfile.ParseEnvFile(source)
+		if err != nil {
+			return nil, err
+		}
+		envs[envName] = vars
+		logger.Printf("read environment from %s", source)
+	} else {
+		cfg, err := readConfigFile()
+		if err != nil {
+			return nil, err
+		}
+
+		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+		envs, err = c.GetApp(ctx, fullAppName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch environments: %v", err)
+		}
+		if _, ok := envs[envName]; !ok {
+			return nil, fmt.Errorf("environment %s not found in app %s", envfile.DisplayName(envName), fullAppName)
+		}
+		logger.Printf("read environment %s from app %s", envfile.DisplayName(envName), fullAppName)
+	}
+
+	return envfile.ResolveInheritance(envs, parents)
+}
@@ -0,0 +1,82 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// ExtendInput represents the input parameters for the extend operation
+type ExtendInput struct {
+	EnvName string
+	// Parent is the environment to inherit from, empty to stop inheriting
+	Parent string
+	// DryRun reports the change without writing the config
+	DryRun bool
+}
+
+// ExtendFn represents a function that performs the extend operation
+type ExtendFn func(context.Context, ExtendInput) error
+
+// NewExtend creates a new extend function with injected dependencies
+func NewExtend(c client.Client, logger logger.Logger) ExtendFn {
+	return func(ctx context.Context, input ExtendInput) error {
+		cfg, err := readConfigFile()
+		if err != nil {
+			return err
+		}
+
+		if input.Parent != "" {
+			fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+			envs, err := c.GetApp(ctx, fullAppName)
+			if err != nil {
+				return fmt.Errorf("failed to fetch environments: %v", err)
+			}
+			if _, ok := envs[envKey(input.Parent)]; !ok {
+				return fmt.Errorf("environment %s not found in app %s", input.Parent, fullAppName)
+			}
+		}
+
+		name := envfile.DisplayName(input.EnvName)
+		if cfg.Environments == nil {
+			cfg.Environments = make(map[string]envConfig)
+		}
+		env := cfg.Environments[name]
+
+		env.Extends = input.Parent
+		if env == (envConfig{}) {
+			delete(cfg.Environments, name)
+		} else {
+			cfg.Environments[name] = env
+		}
+
+		if _, err := envfile.Ancestors(input.EnvName, cfg.parents()); err != nil {
+			return err
+		}
+
+		if input.DryRun {
+			if input.Parent == "" {
+				logger.Printf("would stop environment %s from extending another environment", name)
+			} else {
+				logger.Printf("would make environment %s extend %s", name, input.Parent)
+			}
+			logger.Printf("dry run: %s was not written", filepath.Join(".env0", "config.json"))
+			return nil
+		}
+
+		if err := writeConfigFile(cfg); err != nil {
+			return err
+		}
+
+		if input.Parent == "" {
+			logger.Printf("environment %s no longer extends another environment", name)
+		} else {
+			logger.Printf("environment %s now extends %s", name, input.Parent)
+		}
+		return nil
+	}
+}
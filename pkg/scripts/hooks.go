@@ -0,0 +1,131 @@
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// hookMarker identifies the git hooks written by env0
+const hookMarker = "# installed by env0"
+
+const preCommitHook = `#!/bin/sh
+` + hookMarker + `: blocks commits of env files and secrets
+exec env0 scan
+`
+
+// gitignoreEntries keeps env files and their backups out of git, except for
+// the example file. .env* also matches the .env0 directory, which is included
+// again so the app config and schema stay shareable.
+var gitignoreEntries = []string{".env*", "!" + DefaultExampleFile, "!.env0/", ".env0/backup/"}
+
+// HooksInstallInput represents the input parameters for the hooks install operation
+type HooksInstallInput struct {
+	// Force replaces a pre-commit hook that was not installed by env0
+	Force bool
+}
+
+// HooksInstallFn represents a function that performs the hooks install operation
+type HooksInstallFn func(context.Context, HooksInstallInput) error
+
+// NewHooksInstall creates a new hooks install function with injected dependencies
+func NewHooksInstall(logger logger.Logger) HooksInstallFn {
+	return func(ctx context.Context, input HooksInstallInput) error {
+		hooksDir, err := gitOutput(ctx, "rev-parse", "--git-path", "hooks")
+		if err != nil {
+			return err
+		}
+		hookPath := filepath.Join(strings.TrimSpace(string(hooksDir)), "pre-commit")
+
+		current, err := os.ReadFile(hookPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to read %s: %v", hookPath, err)
+		}
+		if err == nil && !strings.Contains(string(current), hookMarker) && !input.Force {
+			return fmt.Errorf("%s already exists, add \"env0 scan\" to it or use --force to replace it", hookPath)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(hookPath), 0755); err != nil {
+			return fmt.Errorf("failed to create hooks directory: %v", err)
+		}
+		if err := os.WriteFile(hookPath, []byte(preCommitHook), 0755); err != nil {
+			return fmt.Errorf("failed to write %s: %v", hookPath, err)
+		}
+		// WriteFile keeps the mode of existing files
+		if err := os.Chmod(hookPath, 0755); err != nil {
+			return fmt.Errorf("failed to make %s executable: %v", hookPath, err)
+		}
+
+		logger.Printf("installed pre-commit hook at %s", hookPath)
+		return nil
+	}
+}
+
+// gitOutput runs a git command and returns its standard output
+func gitOutput(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("git %s: %v", args[0], err)
+	}
+	return out, nil
+}
+
+// ensureGitignore appends the env0 entries missing from .gitignore
+func ensureGitignore(dryRun bool, logger logger.Logger) error {
+	const fileName = ".gitignore"
+
+	content, err := os.ReadFile(fileName)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %v", fileName, err)
+	}
+
+	present := make(map[string]bool)
+	for _, line := range strings.Split(string(content), "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, entry := range gitignoreEntries {
+		if !present[entry] {
+			missing = append(missing, entry)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if dryRun {
+		logger.Printf("would add %s to %s", strings.Join(missing, ", "), fileName)
+		return nil
+	}
+
+	var b strings.Builder
+	b.Write(content)
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		b.WriteString("\n")
+	}
+	b.WriteString("\n# env0\n")
+	for _, entry := range missing {
+		b.WriteString(entry + "\n")
+	}
+
+	if err := os.WriteFile(fileName, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", fileName, err)
+	}
+	logger.Printf("added %s to %s", strings.Join(missing, ", "), fileName)
+	return nil
+}
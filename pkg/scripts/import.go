@@ -0,0 +1,203 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envdiff"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/mask"
+	"github.com/Jibaru/env0/pkg/prompt"
+)
+
+// ImportInput represents the input parameters for the import operation
+type ImportInput struct {
+	EnvName string
+	File    string
+	// Format of the file, inferred from its extension when empty
+	Format string
+	// Separator joins the keys of nested values when flattening
+	Separator string
+	// Uppercase converts every imported variable name to upper case
+	Uppercase bool
+	// Replace deletes remote variables that are missing from the file
+	Replace bool
+	// Yes pushes without asking for confirmation
+	Yes         bool
+	Interactive bool
+	DryRun      bool
+	// Reveal shows the values of sensitive variables instead of masking them
+	Reveal bool
+}
+
+// ImportFn represents a function that performs the import operation
+type ImportFn func(context.Context, ImportInput) error
+
+// NewImport creates a new import function with injected dependencies
+func NewImport(c client.Client, logger logger.Logger, reader prompt.Reader) ImportFn {
+	return func(ctx context.Context, input ImportInput) error {
+		format := input.Format
+		if format == "" {
+			format = formatFromExtension(input.File)
+		}
+
+		file, err := os.Open(input.File)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %v", input.File, err)
+		}
+		defer file.Close()
+
+		imported, err := envfile.DecodeEnv(format, file, input.Separator)
+		if err != nil {
+			return err
+		}
+		if input.Uppercase {
+			imported, err = uppercaseKeys(imported)
+			if err != nil {
+				return err
+			}
+		}
+
+		cfg, err := readConfigFile()
+		if err != nil {
+			return err
+		}
+
+		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+		if _, err := checkWriteAccess(ctx, c, fullAppName, &input.EnvName); err != nil {
+			return err
+		}
+
+		// Every environment is sent back, so the ones outside the access scope are kept as fetched
+		envs, err := c.GetApp(ctx, fullAppName)
+		if err != nil {
+			return fmt.Errorf("failed to fetch current remote state: %v", err)
+		}
+		if envs == nil {
+			envs = make(map[string]map[string]interface{})
+		}
+
+		remoteVars := envs[input.EnvName]
+		if remoteVars == nil {
+			remoteVars = make(map[string]interface{})
+		}
+
+		mergedVars := make(map[string]interface{})
+		if !input.Replace {
+			for k, v := range remoteVars {
+				mergedVars[k] = v
+			}
+		}
+		for k, v := range imported {
+			mergedVars[k] = v
+		}
+
+		diff := envdiff.CompareMaps(remoteVars, mergedVars)
+		if len(diff.Changes) == 0 {
+			logger.Printf("no changes to import into environment: %s", envfile.DisplayName(input.EnvName))
+			return nil
+		}
+
+		s, err := loadSchema()
+		if err != nil {
+			return err
+		}
+
+		logger.Printf("importing %s into environment %s of app %s", input.File, envfile.DisplayName(input.EnvName), fullAppName)
+		logChanges(diff, newMasker(s, input.EnvName, input.Reveal), logger)
+
+		if s != nil {
+			updated := make(map[string]map[string]interface{}, len(envs))
+			for envName, vars := range envs {
+				updated[envName] = vars
+			}
+			updated[input.EnvName] = mergedVars
+
+			resolved, err := envfile.ResolveInheritance(updated, cfg.parents())
+			if err != nil {
+				return err
+			}
+			if errs := validateEnvironments(s, map[string]map[string]interface{}{input.EnvName: resolved[input.EnvName]}); len(errs) > 0 {
+				return fmt.Errorf("import rejected by schema: %v", errs)
+			}
+		}
+
+		if input.DryRun {
+			logger.Printf("dry run: environments of app %s were not updated", fullAppName)
+			return nil
+		}
+
+		if !input.Yes {
+			if !input.Interactive {
+				return fmt.Errorf("confirmation required but stdin is not a terminal, use --yes")
+			}
+			ok, err := confirm(fmt.Sprintf("Push %d changes to environment %s?", len(diff.Changes), envfile.DisplayName(input.EnvName)), logger, reader)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				logger.Printf("import cancelled")
+				return nil
+			}
+		}
+
+		envs[input.EnvName] = mergedVars
+		if err := c.UpdateApp(ctx, fullAppName, envs); err != nil {
+			return fmt.Errorf("failed to update environments: %v", err)
+		}
+
+		logger.Printf("imported %d changes into environment %s", len(diff.Changes), envfile.DisplayName(input.EnvName))
+		return nil
+	}
+}
+
+// formatFromExtension guesses the import format of a file, defaulting to dotenv
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".properties":
+		return "properties"
+	default:
+		return "dotenv"
+	}
+}
+
+func uppercaseKeys(vars map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		upper := strings.ToUpper(k)
+		if _, exists := result[upper]; exists {
+			return nil, fmt.Errorf("variable %s is defined more than once after converting to upper case", upper)
+		}
+		result[upper] = v
+	}
+	return result, nil
+}
+
+// logChanges prints a diff sorted by variable name
+func logChanges(diff envdiff.DiffResult, masker *mask.Masker, logger logger.Logger) {
+	changes := slices.Clone(diff.Changes)
+	slices.SortFunc(changes, func(a, b envdiff.Change) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, change := range changes {
+		switch change.Type {
+		case envdiff.Added:
+			logger.Printf("  + %s=%s", change.Name, masker.Value(change.Name, change.NewValue))
+		case envdiff.Modified:
+			logger.Printf("  ~ %s: %s -> %s", change.Name, masker.Value(change.Name, change.OldValue), masker.Value(change.Name, change.NewValue))
+		case envdiff.Deleted:
+			logger.Printf("  - %s", change.Name)
+		}
+	}
+}
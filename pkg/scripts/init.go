@@ -14,6 +14,8 @@ import (
 // InitInput represents the input parameters for the init operation
 type InitInput struct {
 	AppName string
+	// Org owns the app instead of the authenticated user when set
+	Org string
 }
 
 // InitConfig represents the configuration structure for the app
@@ -36,7 +38,7 @@ func NewInit(c client.Client, logger logger.Logger) InitFn {
 		logger.Printf("creating new app: %s", input.AppName)
 
 		// Create the app via API
-		ownerName, err := c.CreateApp(ctx, input.AppName)
+		ownerName, err := c.CreateApp(ctx, input.AppName, input.Org)
 		if err != nil {
 			return fmt.Errorf("failed to create app: %v", err)
 		}
@@ -59,6 +61,10 @@ func NewInit(c client.Client, logger logger.Logger) InitFn {
 			return fmt.Errorf("failed to write config file: %v", err)
 		}
 
+		if err := ensureGitignore(false, logger); err != nil {
+			return err
+		}
+
 		logger.Printf("app %s created successfully", input.AppName)
 		return nil
 	}
@@ -0,0 +1,144 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+)
+
+// InvitesInput represents the input parameters for the invites operation
+type InvitesInput struct {
+	// Sent lists the pending invites of the current app instead of the received ones
+	Sent bool
+}
+
+// InvitesFn represents a function that performs the invites operation
+type InvitesFn func(context.Context, InvitesInput) error
+
+// NewInvites creates a new invites function with injected dependencies
+func NewInvites(c client.Client, logger logger.Logger, renderer output.Renderer) InvitesFn {
+	return func(ctx context.Context, input InvitesInput) error {
+		if input.Sent {
+			cfg, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+
+			fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+			logger.Printf("listing pending invites of app %s", fullAppName)
+
+			invites, err := c.ListAppInvites(ctx, fullAppName)
+			if err != nil {
+				return fmt.Errorf("failed to list invites: %v", err)
+			}
+			if invites == nil {
+				invites = []client.Invite{}
+			}
+
+			rows := output.Rows{
+				Headers: []string{"ID", "INVITEE", "ROLE", "ENVIRONMENTS", "CREATED"},
+				Empty:   "no pending invites",
+			}
+			for _, invite := range invites {
+				invitee := invite.Username
+				if invitee == "" {
+					invitee = invite.Email
+				}
+				rows.Values = append(rows.Values, []string{invite.ID, invitee, string(invite.Role), accessScope(client.Access{Envs: invite.Envs}), invite.CreatedAt})
+			}
+			return renderer.Render(invites, rows)
+		}
+
+		invites, err := c.ListInvites(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list invites: %v", err)
+		}
+		if invites == nil {
+			invites = []client.Invite{}
+		}
+
+		rows := output.Rows{
+			Headers: []string{"ID", "APP", "ROLE", "ENVIRONMENTS", "INVITED BY", "CREATED"},
+			Empty:   "no pending invites",
+		}
+		for _, invite := range invites {
+			rows.Values = append(rows.Values, []string{invite.ID, invite.App, string(invite.Role), accessScope(client.Access{Envs: invite.Envs}), invite.InvitedBy, invite.CreatedAt})
+		}
+		return renderer.Render(invites, rows)
+	}
+}
+
+// AnswerInviteInput represents the input parameters for accepting or declining an invite
+type AnswerInviteInput struct {
+	ID     string
+	Accept bool
+}
+
+// AnswerInviteFn represents a function that accepts or declines an invite
+type AnswerInviteFn func(context.Context, AnswerInviteInput) error
+
+// NewAnswerInvite creates a new function accepting or declining invites with injected dependencies
+func NewAnswerInvite(c client.Client, logger logger.Logger) AnswerInviteFn {
+	return func(ctx context.Context, input AnswerInviteInput) error {
+		if !input.Accept {
+			if err := c.DeclineInvite(ctx, input.ID); err != nil {
+				return fmt.Errorf("failed to decline invite: %v", err)
+			}
+			logger.Printf("invite %s declined", input.ID)
+			return nil
+		}
+
+		// Look the invite up first to tell which app was joined
+		invites, err := c.ListInvites(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list invites: %v", err)
+		}
+
+		var app string
+		for _, invite := range invites {
+			if invite.ID == input.ID {
+				app = invite.App
+			}
+		}
+		if app == "" {
+			return fmt.Errorf("no pending invite with id %s", input.ID)
+		}
+
+		if err := c.AcceptInvite(ctx, input.ID); err != nil {
+			return fmt.Errorf("failed to accept invite: %v", err)
+		}
+
+		logger.Printf("invite %s accepted, get the environments of app %s with: env0 clone %s", input.ID, app, app)
+		return nil
+	}
+}
+
+// RevokeInviteInput represents the input parameters for the revoke invite operation
+type RevokeInviteInput struct {
+	ID string
+}
+
+// RevokeInviteFn represents a function that performs the revoke invite operation
+type RevokeInviteFn func(context.Context, RevokeInviteInput) error
+
+// NewRevokeInvite creates a new revoke invite function with injected dependencies
+func NewRevokeInvite(c client.Client, logger logger.Logger) RevokeInviteFn {
+	return func(ctx context.Context, input RevokeInviteInput) error {
+		cfg, err := readConfigFile()
+		if err != nil {
+			return err
+		}
+
+		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+
+		if err := c.RevokeInvite(ctx, fullAppName, input.ID); err != nil {
+			return fmt.Errorf("failed to revoke invite: %v", err)
+		}
+
+		logger.Printf("invite %s to app %s revoked", input.ID, fullAppName)
+		return nil
+	}
+}
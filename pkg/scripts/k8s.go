@@ -0,0 +1,118 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/k8s"
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// K8sInput represents the input parameters for the k8s manifest operation
+type K8sInput struct {
+	EnvName     string
+	Kind        string
+	Name        string
+	Namespace   string
+	Labels      []string
+	Annotations []string
+	// Include keeps only the variables matching one of these glob patterns
+	Include []string
+	// Exclude drops the variables matching one of these glob patterns
+	Exclude []string
+	Local   bool
+	File    string
+	// Raw keeps ${...} references unexpanded
+	Raw bool
+}
+
+// K8sFn represents a function that performs the k8s manifest operation
+type K8sFn func(context.Context, K8sInput) error
+
+// NewK8s creates a new k8s manifest function with injected dependencies
+func NewK8s(c client.Client, logger logger.Logger, w io.Writer) K8sFn {
+	return func(ctx context.Context, input K8sInput) error {
+		kind, err := k8s.ParseKind(input.Kind)
+		if err != nil {
+			return err
+		}
+
+		labels, err := k8s.ParsePairs(input.Labels)
+		if err != nil {
+			return err
+		}
+		annotations, err := k8s.ParsePairs(input.Annotations)
+		if err != nil {
+			return err
+		}
+
+		load := loadResolvedEnvironment
+		if input.Raw {
+			load = loadEnvironment
+		}
+		vars, err := load(ctx, c, input.EnvName, input.Local, input.File, logger)
+		if err != nil {
+			return err
+		}
+
+		vars, err = filterKeys(vars, input.Include, input.Exclude)
+		if err != nil {
+			return err
+		}
+
+		manifest, err := k8s.NewManifest(kind, k8s.Metadata{
+			Name:        input.Name,
+			Namespace:   input.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		}, vars)
+		if err != nil {
+			return err
+		}
+
+		data, err := manifest.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to render manifest: %v", err)
+		}
+
+		_, err = w.Write(data)
+		return err
+	}
+}
+
+// filterKeys keeps the variables matching include patterns, if any, and not matching exclude patterns
+func filterKeys(vars map[string]interface{}, include, exclude []string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for k, v := range vars {
+		keep := len(include) == 0
+		for _, pattern := range include {
+			matched, err := path.Match(pattern, k)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+			}
+			if matched {
+				keep = true
+				break
+			}
+		}
+
+		for _, pattern := range exclude {
+			matched, err := path.Match(pattern, k)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+			}
+			if matched {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,149 @@
+package scripts
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Default layout of the environment files
+const (
+	DefaultLayoutPattern = ".env.*"
+	DefaultLayoutFile    = ".env"
+)
+
+// layoutConfig declares where environment files live, relative to the project directory
+type layoutConfig struct {
+	// Pattern is the path of the environment files, with * standing for the environment name
+	Pattern string `json:"pattern,omitempty"`
+	// Default is the path of the default environment file
+	Default string `json:"default,omitempty"`
+}
+
+// fileLayout maps environment names to file paths and back
+type fileLayout struct {
+	pattern     string
+	defaultFile string
+	// paths holds the environments mapped to a path of their own
+	paths map[string]string
+}
+
+// defaultLayout is the .env and .env.<name> layout
+func defaultLayout() fileLayout {
+	return fileLayout{pattern: DefaultLayoutPattern, defaultFile: DefaultLayoutFile, paths: map[string]string{}}
+}
+
+// validateLayoutPattern checks that a pattern holds exactly one *, and no other glob syntax
+func validateLayoutPattern(pattern string) error {
+	if strings.Count(pattern, "*") != 1 || strings.ContainsAny(pattern, "?[") {
+		return fmt.Errorf("invalid layout pattern %q, it must contain a single * standing for the environment name", pattern)
+	}
+	return nil
+}
+
+// layout returns the file layout declared in the config
+func (c *config) layout() (fileLayout, error) {
+	l := defaultLayout()
+	if c.Layout != nil {
+		if c.Layout.Pattern != "" {
+			if err := validateLayoutPattern(c.Layout.Pattern); err != nil {
+				return fileLayout{}, err
+			}
+			l.pattern = filepath.Clean(c.Layout.Pattern)
+		}
+		if c.Layout.Default != "" {
+			l.defaultFile = filepath.Clean(c.Layout.Default)
+		}
+	}
+
+	for envName, env := range c.Environments {
+		if env.Path != "" {
+			l.paths[envKey(envName)] = filepath.Clean(env.Path)
+		}
+	}
+	return l, nil
+}
+
+// readLayout returns the file layout of the app, or the default one if the app is not initialized
+func readLayout() (fileLayout, error) {
+	if !isInitialized() {
+		return defaultLayout(), nil
+	}
+
+	cfg, err := readConfigFile()
+	if err != nil {
+		return fileLayout{}, err
+	}
+	return cfg.layout()
+}
+
+// fileName returns the path of the file of an environment
+func (l fileLayout) fileName(envName string) string {
+	if path, ok := l.paths[envName]; ok {
+		return path
+	}
+	if envName == "" {
+		return l.defaultFile
+	}
+	return strings.Replace(l.pattern, "*", envName, 1)
+}
+
+// envName returns the environment stored in a file, if the file belongs to the layout
+func (l fileLayout) envName(path string) (string, bool) {
+	path = filepath.Clean(path)
+	for envName, p := range l.paths {
+		if p == path {
+			return envName, true
+		}
+	}
+	if path == l.defaultFile {
+		return "", true
+	}
+
+	prefix, suffix, _ := strings.Cut(l.pattern, "*")
+	if len(path) <= len(prefix)+len(suffix) || !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	envName := path[len(prefix) : len(path)-len(suffix)]
+	if strings.ContainsRune(envName, filepath.Separator) {
+		return "", false
+	}
+	// Environments mapped to a path of their own are not read from the pattern
+	if _, ok := l.paths[envName]; ok {
+		return "", false
+	}
+	return envName, true
+}
+
+// files returns the existing environment files of the layout, by environment name
+func (l fileLayout) files() (map[string]string, error) {
+	candidates := []string{l.defaultFile}
+	for _, path := range l.paths {
+		candidates = append(candidates, path)
+	}
+	matches, err := filepath.Glob(l.pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid layout pattern %q: %v", l.pattern, err)
+	}
+	candidates = append(candidates, matches...)
+	sort.Strings(candidates)
+
+	files := make(map[string]string)
+	for _, path := range candidates {
+		// The example file holds placeholders, not an environment
+		if filepath.Base(path) == DefaultExampleFile {
+			continue
+		}
+		envName, ok := l.envName(path)
+		if !ok {
+			continue
+		}
+		if info, err := os.Stat(path); err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		files[envName] = path
+	}
+	return files, nil
+}
@@ -3,44 +3,107 @@ package scripts
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 // ListAppsInput represents the input parameters for the list apps operation
 type ListAppsInput struct {
-	// Empty since we don't need any input parameters for listing all apps
+	Page      int
+	Limit     int
+	SortOrder string
+	Search    string
+	// All walks every page instead of fetching a single one
+	All bool
+}
+
+// AppView is the reported form of a client.App.
+// Envs lists environment names only so values never reach the output.
+type AppView struct {
+	ID                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	UserID               string   `json:"userId"`
+	Envs                 []string `json:"envs"`
+	OtherUsersAllowedIds []string `json:"otherUsersAllowedIds"`
+	CreatedAt            string   `json:"createdAt"`
 }
 
 // ListAppsFn represents a function that performs the list apps operation
 type ListAppsFn func(context.Context, ListAppsInput) error
 
 // NewListApps creates a new list apps function with injected dependencies
-func NewListApps(c client.Client, logger logger.Logger) ListAppsFn {
+func NewListApps(c client.Client, logger logger.Logger, renderer output.Renderer) ListAppsFn {
 	return func(ctx context.Context, input ListAppsInput) error {
-		logger.Printf("listing all apps")
+		sortOrder := strings.ToLower(input.SortOrder)
+		if sortOrder == "" {
+			sortOrder = "desc"
+		}
+		if sortOrder != "asc" && sortOrder != "desc" {
+			return fmt.Errorf("invalid sort order %q, expected asc or desc", input.SortOrder)
+		}
+		if input.Page < 0 || input.Limit < 0 {
+			return fmt.Errorf("page and limit must not be negative")
+		}
+		if input.All && input.Page > 0 {
+			return fmt.Errorf("--all cannot be combined with --page")
+		}
 
-		// Call with no pagination to get all apps
-		apps, err := c.ListApps(ctx, 0, 0, "desc", "")
+		var apps []client.App
+		var err error
+		if input.All {
+			logger.Printf("listing all apps")
+			apps, err = client.ListAllApps(ctx, c, input.Limit, sortOrder, input.Search)
+		} else {
+			logger.Printf("listing apps")
+			apps, err = c.ListApps(ctx, input.Page, input.Limit, sortOrder, input.Search)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to list apps: %v", err)
 		}
 
-		// Print each app's information
+		views := make([]AppView, 0, len(apps))
+		rows := output.Rows{
+			Headers: []string{"NAME", "ID", "CREATED", "ENVS", "OTHER USERS"},
+			Empty:   "no apps found",
+		}
 		for _, app := range apps {
-			logger.Printf("App: %s", app.Name)
-			logger.Printf("  ID: %s", app.ID)
-			logger.Printf("  Created: %s", app.CreatedAt)
-			logger.Printf("  Environment count: %d", len(app.Envs))
-			logger.Printf("  Other users count: %d", len(app.OtherUsersAllowedIds))
-			logger.Printf("---")
+			view := newAppView(app)
+			views = append(views, view)
+			rows.Values = append(rows.Values, []string{
+				view.Name,
+				view.ID,
+				view.CreatedAt,
+				strings.Join(view.Envs, ","),
+				fmt.Sprintf("%d", len(view.OtherUsersAllowedIds)),
+			})
 		}
 
-		if len(apps) == 0 {
-			logger.Printf("no apps found")
-		}
+		return renderer.Render(views, rows)
+	}
+}
+
+func newAppView(app client.App) AppView {
+	envs := make([]string, 0, len(app.Envs))
+	for name := range app.Envs {
+		envs = append(envs, name)
+	}
+	slices.Sort(envs)
+
+	others := app.OtherUsersAllowedIds
+	if others == nil {
+		others = []string{}
+	}
 
-		return nil
+	return AppView{
+		ID:                   app.ID,
+		Name:                 app.Name,
+		UserID:               app.UserID,
+		Envs:                 envs,
+		OtherUsersAllowedIds: others,
+		CreatedAt:            app.CreatedAt,
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 // ListUsersInput represents the input parameters for the list users operation
@@ -20,7 +21,7 @@ type ListUsersInput struct {
 type ListUsersFn func(context.Context, ListUsersInput) error
 
 // NewListUsers creates a new list users function with injected dependencies
-func NewListUsers(c client.Client, logger logger.Logger) ListUsersFn {
+func NewListUsers(c client.Client, logger logger.Logger, renderer output.Renderer) ListUsersFn {
 	return func(ctx context.Context, input ListUsersInput) error {
 		cfgData, err := os.ReadFile(filepath.Join(".env0", "config.json"))
 		if err != nil {
@@ -43,23 +44,19 @@ func NewListUsers(c client.Client, logger logger.Logger) ListUsersFn {
 			return fmt.Errorf("failed to list users: %v", err)
 		}
 
-		// Print each user's information
-		for _, user := range users {
-			logger.Printf("User: %s", user.Username)
-			logger.Printf("  ID: %s", user.ID)
-			logger.Printf("  Email: %s", user.Email)
-			if user.IsOwner {
-				logger.Printf("  Role: Owner")
-			} else {
-				logger.Printf("  Role: Collaborator")
-			}
-			logger.Printf("---")
+		rows := output.Rows{
+			Headers: []string{"USERNAME", "ID", "EMAIL", "ROLE", "ENVIRONMENTS"},
+			Empty:   "no users found",
 		}
-
-		if len(users) == 0 {
-			logger.Printf("no users found")
+		listed := make([]client.AppUser, 0, len(users))
+		for _, user := range users {
+			// Users listed before roles existed only have IsOwner
+			access := user.Access()
+			user.Role = access.Role
+			listed = append(listed, user)
+			rows.Values = append(rows.Values, []string{user.Username, user.ID, user.Email, string(access.Role), accessScope(access)})
 		}
 
-		return nil
+		return renderer.Render(listed, rows)
 	}
 }
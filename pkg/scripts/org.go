@@ -0,0 +1,129 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+)
+
+// OrgCreateInput represents the input parameters for the org create operation
+type OrgCreateInput struct {
+	Name string
+}
+
+// OrgCreateFn represents a function that performs the org create operation
+type OrgCreateFn func(context.Context, OrgCreateInput) error
+
+// NewOrgCreate creates a new org create function with injected dependencies
+func NewOrgCreate(c client.Client, logger logger.Logger) OrgCreateFn {
+	return func(ctx context.Context, input OrgCreateInput) error {
+		if input.Name == "" || strings.Contains(input.Name, "/") {
+			return fmt.Errorf("invalid organization name %q", input.Name)
+		}
+
+		logger.Printf("creating organization: %s", input.Name)
+
+		if err := c.CreateOrg(ctx, input.Name); err != nil {
+			return fmt.Errorf("failed to create organization: %v", err)
+		}
+
+		logger.Printf("organization %s created successfully, create apps in it with: env0 init <app> --org %s", input.Name, input.Name)
+		return nil
+	}
+}
+
+// OrgMemberAddInput represents the input parameters for the org members add operation
+type OrgMemberAddInput struct {
+	Org      string
+	Username string
+	// Role within the organization, member when empty
+	Role client.OrgRole
+	// Teams the user joins, replacing the previous ones
+	Teams []string
+}
+
+// OrgMemberAddFn represents a function that performs the org members add operation
+type OrgMemberAddFn func(context.Context, OrgMemberAddInput) error
+
+// NewOrgMemberAdd creates a new org members add function with injected dependencies
+func NewOrgMemberAdd(c client.Client, logger logger.Logger) OrgMemberAddFn {
+	return func(ctx context.Context, input OrgMemberAddInput) error {
+		member := client.OrgMember{Username: input.Username, Role: input.Role, Teams: input.Teams}
+		if member.Role == "" {
+			member.Role = client.OrgRoleMember
+		}
+
+		logger.Printf("adding %s to organization %s as %s", input.Username, input.Org, member.Role)
+
+		if err := c.AddOrgMember(ctx, input.Org, member); err != nil {
+			return fmt.Errorf("failed to add member: %v", err)
+		}
+
+		if len(member.Teams) > 0 {
+			logger.Printf("user %s is a member of teams %s", input.Username, strings.Join(member.Teams, ", "))
+		}
+		logger.Printf("user %s successfully added to organization %s", input.Username, input.Org)
+		return nil
+	}
+}
+
+// OrgMemberRemoveInput represents the input parameters for the org members remove operation
+type OrgMemberRemoveInput struct {
+	Org      string
+	Username string
+}
+
+// OrgMemberRemoveFn represents a function that performs the org members remove operation
+type OrgMemberRemoveFn func(context.Context, OrgMemberRemoveInput) error
+
+// NewOrgMemberRemove creates a new org members remove function with injected dependencies
+func NewOrgMemberRemove(c client.Client, logger logger.Logger) OrgMemberRemoveFn {
+	return func(ctx context.Context, input OrgMemberRemoveInput) error {
+		logger.Printf("removing %s from organization %s", input.Username, input.Org)
+
+		if err := c.RemoveOrgMember(ctx, input.Org, input.Username); err != nil {
+			return fmt.Errorf("failed to remove member: %v", err)
+		}
+
+		logger.Printf("user %s successfully removed from organization %s, the apps of the organization are kept", input.Username, input.Org)
+		return nil
+	}
+}
+
+// OrgMembersInput represents the input parameters for the org members list operation
+type OrgMembersInput struct {
+	Org string
+}
+
+// OrgMembersFn represents a function that performs the org members list operation
+type OrgMembersFn func(context.Context, OrgMembersInput) error
+
+// NewOrgMembers creates a new org members list function with injected dependencies
+func NewOrgMembers(c client.Client, logger logger.Logger, renderer output.Renderer) OrgMembersFn {
+	return func(ctx context.Context, input OrgMembersInput) error {
+		logger.Printf("listing members of organization %s", input.Org)
+
+		members, err := c.ListOrgMembers(ctx, input.Org)
+		if err != nil {
+			return fmt.Errorf("failed to list members: %v", err)
+		}
+
+		if members == nil {
+			members = []client.OrgMember{}
+		}
+
+		rows := output.Rows{
+			Headers: []string{"USERNAME", "ROLE", "TEAMS"},
+			Empty:   "no members found",
+		}
+		for _, member := range members {
+			rows.Values = append(rows.Values, []string{member.Username, string(member.Role), strings.Join(member.Teams, ", ")})
+		}
+
+		return renderer.Render(members, rows)
+	}
+}
@@ -2,19 +2,35 @@ package scripts
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"strings"
+	"time"
 
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/envdiff"
 	"github.com/Jibaru/env0/pkg/envfile"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/schema"
 )
 
 // PullInput represents the input parameters for the pull operation
 type PullInput struct {
 	TargetEnv *string
+	// DryRun reports the planned file changes without writing them
+	DryRun bool
+	// Reveal shows the values of sensitive variables instead of masking them
+	Reveal bool
+	// All pulls every app of the workspace, cloning those not initialized yet
+	All bool
+	// Watch keeps polling the app after pulling and applies the remote changes
+	Watch bool
+	// Interval between polls in watch mode, must be positive
+	Interval time.Duration
+	// Inherited also writes the variables inherited from parent environments
+	// into the files of their children, which otherwise hold their own variables only
+	Inherited bool
 }
 
 // PullFn represents a function that performs the pull operation
@@ -23,47 +39,125 @@ type PullFn func(context.Context, PullInput) error
 // NewPull creates a new pull function with injected dependencies
 func NewPull(c client.Client, logger logger.Logger) PullFn {
 	return func(ctx context.Context, input PullInput) error {
-		cfg, err := readConfigFile()
+		if input.Watch && (input.All || input.DryRun) {
+			return fmt.Errorf("--watch cannot be combined with --all or --dry-run")
+		}
+		if input.Watch && input.Interval <= 0 {
+			return fmt.Errorf("--interval must be positive, got %s", input.Interval)
+		}
+		if !input.All {
+			return pullApp(ctx, c, input, logger)
+		}
+
+		ws, err := findWorkspace()
 		if err != nil {
 			return err
 		}
 
-		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
-		logger.Printf("pulling environments from app %s", fullAppName)
+		clone := NewClone(c, logger)
+		return ws.Each(logger, func(app WorkspaceApp) error {
+			if !isInitialized() {
+				if app.App == "" {
+					return fmt.Errorf("app not initialized, set its name in %s to clone it", WorkspaceFile)
+				}
+				return clone(ctx, CloneInput{FullAppName: app.App, DryRun: input.DryRun})
+			}
+			return pullApp(ctx, c, input, logger)
+		})
+	}
+}
 
-		envs, err := c.GetApp(ctx, fullAppName)
-		if err != nil {
-			return fmt.Errorf("failed to fetch environments: %v", err)
-		}
+// pullApp pulls the environments of the app in the working directory
+func pullApp(ctx context.Context, c client.Client, input PullInput, logger logger.Logger) error {
+	cfg, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+	logger.Printf("pulling environments from app %s", fullAppName)
+
+	access, err := loadAccess(ctx, c, fullAppName)
+	if err != nil {
+		return err
+	}
+	if err := checkTargetAccess(input.TargetEnv, access); err != nil {
+		return err
+	}
 
-		if err := processEnvironmentUpdates(envs, input.TargetEnv, logger); err != nil {
+	envs, err := fetchPullEnvironments(ctx, c, cfg, access, input.Inherited, logger)
+	if err != nil {
+		return err
+	}
+
+	s, err := loadSchema()
+	if err != nil {
+		return err
+	}
+
+	layout, err := cfg.layout()
+	if err != nil {
+		return err
+	}
+
+	batch := envfile.NewBatch()
+	if err := processEnvironmentUpdates(envs, layout, s, input, batch, logger); err != nil {
+		batch.Rollback()
+		return err
+	}
+
+	if input.DryRun {
+		logger.Printf("dry run: no files were written")
+		return nil
+	}
+
+	if batch.Len() > 0 {
+		backupDir := newBackupDir()
+		if err := batch.Commit(backupDir); err != nil {
+			return err
+		}
+		logger.Printf("previous files backed up to %s", backupDir)
+		if err := pruneBackups(logger); err != nil {
 			return err
 		}
+	}
 
-		logger.Printf("environments pulled successfully")
-		return nil
+	logger.Printf("environments pulled successfully")
+
+	if input.Watch {
+		return watchPull(ctx, c, cfg, access, envs, layout, input, logger)
 	}
+	return nil
 }
 
-func getEnvFileName(envName string) string {
-	if envName == "" {
-		return ".env"
+// fetchPullEnvironments fetches the environments the user can read, with
+// their inherited variables when asked to
+func fetchPullEnvironments(ctx context.Context, c client.Client, cfg *config, access client.Access, inherited bool, logger logger.Logger) (map[string]map[string]interface{}, error) {
+	envs, err := c.GetApp(ctx, fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch environments: %v", err)
 	}
-	return fmt.Sprintf(".env.%s", envName)
+	envs = filterByAccess(envs, access, logger)
+
+	if !inherited {
+		return envs, nil
+	}
+	return envfile.ResolveInheritance(envs, cfg.parents())
 }
 
-func processEnvironmentUpdates(envs map[string]map[string]interface{}, targetEnv *string, logger logger.Logger) error {
+// processEnvironmentUpdates stages the merged env files in the batch without replacing them
+func processEnvironmentUpdates(envs map[string]map[string]interface{}, layout fileLayout, s *schema.Schema, input PullInput, batch *envfile.Batch, logger logger.Logger) error {
 	for envName, remoteVars := range envs {
-		if targetEnv != nil && envName != *targetEnv {
+		if input.TargetEnv != nil && envName != *input.TargetEnv {
 			continue
 		}
 
-		fileName := getEnvFileName(envName)
+		fileName := layout.fileName(envName)
 
 		// Load current environment if it exists
 		currentVars, err := envfile.ParseEnvFile(fileName)
 		if err != nil {
-			if !os.IsNotExist(err) {
+			if !errors.Is(err, fs.ErrNotExist) {
 				return fmt.Errorf("failed to parse current env file %s: %v", fileName, err)
 			}
 			currentVars = make(map[string]interface{})
@@ -77,15 +171,30 @@ func processEnvironmentUpdates(envs map[string]map[string]interface{}, targetEnv
 			continue
 		}
 
+		// Values are shown masked, unless the schema marks them as not sensitive
+		masker := newMasker(s, envName, input.Reveal)
+
 		// If there are changes, decide what to do
 		if diff.SafeToMerge {
+			if input.DryRun {
+				logChanges(diff, masker, logger)
+				logger.Printf("would write %s", fileName)
+				continue
+			}
+
 			// Only new variables, safe to merge
 			mergedVars := envdiff.MergeMaps(currentVars, remoteVars, diff)
-			if err := envfile.WriteEnvFile(fileName, mergedVars); err != nil {
+			if err := batch.Stage(fileName, envfile.Marshal(mergedVars)); err != nil {
 				return fmt.Errorf("failed to write merged env file %s: %v", fileName, err)
 			}
 			logger.Printf("safely merged %d new variables into %s", len(diff.Changes), fileName)
 		} else {
+			if input.DryRun {
+				logChanges(diff, masker, logger)
+				logger.Printf("would write %s with conflict markers for the modified and deleted variables", fileName)
+				continue
+			}
+
 			// Write conflicts directly to the env file
 			var content strings.Builder
 
@@ -114,11 +223,12 @@ func processEnvironmentUpdates(envs map[string]map[string]interface{}, targetEnv
 				}
 			}
 
-			if err := os.WriteFile(fileName, []byte(content.String()), 0644); err != nil {
+			if err := batch.Stage(fileName, []byte(content.String())); err != nil {
 				return fmt.Errorf("failed to write conflict markers to %s: %v", fileName, err)
 			}
 
 			logger.Printf("detected conflicts in %s, marked conflicts in file with git-style markers", fileName)
+			logChanges(diff, masker, logger)
 			logger.Printf("please resolve conflicts manually and run push when ready")
 		}
 	}
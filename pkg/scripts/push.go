@@ -6,23 +6,91 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/envdiff"
 	"github.com/Jibaru/env0/pkg/envfile"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/mask"
 	"github.com/Jibaru/env0/pkg/prompt"
+	"github.com/Jibaru/env0/pkg/schema"
 )
 
 type config struct {
-	AppName   string `json:"appName"`
-	OwnerName string `json:"ownerName"`
+	AppName      string               `json:"appName"`
+	OwnerName    string               `json:"ownerName"`
+	Environments map[string]envConfig `json:"environments,omitempty"`
+	// Layout overrides where environment files live
+	Layout *layoutConfig `json:"layout,omitempty"`
+}
+
+// envConfig holds the local settings of an environment, keyed by its name
+// in config.json where "default" stands for the .env file
+type envConfig struct {
+	// Extends names the parent environment whose variables are inherited
+	Extends string `json:"extends,omitempty"`
+	// Path is the file of the environment, overriding the layout pattern
+	Path string `json:"path,omitempty"`
+}
+
+// parents maps each environment to the one it extends
+func (c *config) parents() map[string]string {
+	parents := make(map[string]string)
+	for envName, env := range c.Environments {
+		if env.Extends != "" {
+			parents[envKey(envName)] = envKey(env.Extends)
+		}
+	}
+	return parents
+}
+
+// envKey converts the name users type for an environment into its key
+func envKey(name string) string {
+	if name == "default" {
+		return ""
+	}
+	return name
 }
 
 // PushInput represents the input parameters for the push operation
 type PushInput struct {
 	TargetEnv *string
+	Policy    PushPolicy
+	// Interactive reports whether confirmations can be read from the prompt reader
+	Interactive bool
+	// Expand pushes values with ${...} references resolved instead of the references
+	Expand bool
+	// Reveal shows the values of sensitive variables in prompts instead of masking them
+	Reveal bool
+	// All pushes every app of the workspace
+	All bool
+}
+
+// PushPolicy controls how push resolves modifications and deletions without prompting
+type PushPolicy struct {
+	// Yes accepts every modification and deletion
+	Yes bool
+	// NoDelete keeps remote variables that are missing locally
+	NoDelete bool
+	// OnlyAdd pushes new variables only, skipping modifications and deletions
+	OnlyAdd bool
+	// Force replaces the remote environments with the local files as-is
+	Force bool
+	// DryRun reports the changes without updating the remote app
+	DryRun bool
+}
+
+// Validate checks that the selected policies can be combined
+func (p PushPolicy) Validate() error {
+	if p.Force && (p.NoDelete || p.OnlyAdd) {
+		return fmt.Errorf("--force cannot be combined with --no-delete or --only-add")
+	}
+	if p.OnlyAdd && p.Yes {
+		return fmt.Errorf("--only-add cannot be combined with --yes")
+	}
+	return nil
 }
 
 // PushFn represents a function that performs the push operation
@@ -31,47 +99,108 @@ type PushFn func(context.Context, PushInput) error
 // NewPush creates a new push function with injected dependencies
 func NewPush(c client.Client, logger logger.Logger, reader prompt.Reader) PushFn {
 	return func(ctx context.Context, input PushInput) error {
-		cfg, err := readConfigFile()
-		if err != nil {
+		if err := input.Policy.Validate(); err != nil {
 			return err
 		}
 
-		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
-		logger.Printf("reading environment files for app %s", fullAppName)
-
-		// Get current remote state first
-		remoteEnvs, err := c.GetApp(ctx, fullAppName)
-		if err != nil {
-			return fmt.Errorf("failed to fetch current remote state: %v", err)
+		if !input.All {
+			return pushApp(ctx, c, input, logger, reader)
 		}
 
-		// Process local environment files
-		localEnvs, err := processEnvFiles(input.TargetEnv, logger)
+		ws, err := findWorkspace()
 		if err != nil {
 			return err
 		}
 
-		// Compare and merge changes
-		mergedEnvs, err := processPushUpdates(localEnvs, remoteEnvs, input.TargetEnv, logger, reader)
+		return ws.Each(logger, func(app WorkspaceApp) error {
+			return pushApp(ctx, c, input, logger, reader)
+		})
+	}
+}
+
+// pushApp pushes the environment files of the app in the working directory
+func pushApp(ctx context.Context, c client.Client, input PushInput, logger logger.Logger, reader prompt.Reader) error {
+	cfg, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+	logger.Printf("reading environment files for app %s", fullAppName)
+
+	access, err := checkWriteAccess(ctx, c, fullAppName, input.TargetEnv)
+	if err != nil {
+		return err
+	}
+
+	// Get current remote state first
+	remoteEnvs, err := c.GetApp(ctx, fullAppName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch current remote state: %v", err)
+	}
+
+	// Process local environment files
+	layout, err := cfg.layout()
+	if err != nil {
+		return err
+	}
+	localEnvs, err := processEnvFiles(layout, input.TargetEnv, logger)
+	if err != nil {
+		return err
+	}
+
+	// Environments outside the access scope are neither compared nor changed,
+	// but the update replaces every environment so remoteEnvs is kept whole
+	localEnvs = filterByAccess(localEnvs, access, logger)
+
+	// Inherited values live in the parent environment, not in the pushed one
+	parents := cfg.parents()
+	localEnvs, err = stripInherited(layout, localEnvs, remoteEnvs, parents)
+	if err != nil {
+		return err
+	}
+
+	if input.Expand {
+		localEnvs, err = expandLocalEnvironments(layout, localEnvs, remoteEnvs, parents)
 		if err != nil {
 			return err
 		}
+	}
 
-		if mergedEnvs != nil {
-			logger.Printf("pushing environments to app %s", fullAppName)
-			if err := c.UpdateApp(ctx, fullAppName, mergedEnvs); err != nil {
-				return fmt.Errorf("failed to update environments: %v", err)
-			}
-			logger.Printf("environments pushed successfully")
-		} else {
-			logger.Printf("no changes to push")
+	s, err := loadSchema()
+	if err != nil {
+		return err
+	}
+
+	// Compare and merge changes
+	mergedEnvs, err := processPushUpdates(localEnvs, remoteEnvs, s, input, logger, reader)
+	if err != nil {
+		return err
+	}
+
+	// Validate the environments being pushed before they reach the remote app
+	if mergedEnvs != nil && s != nil {
+		if err := validatePushedEnvironments(s, mergedEnvs, localEnvs, parents); err != nil {
+			return err
 		}
+	}
 
-		return nil
+	if mergedEnvs != nil && input.Policy.DryRun {
+		logger.Printf("dry run: environments of app %s were not updated", fullAppName)
+	} else if mergedEnvs != nil {
+		logger.Printf("pushing environments to app %s", fullAppName)
+		if err := c.UpdateApp(ctx, fullAppName, mergedEnvs); err != nil {
+			return fmt.Errorf("failed to update environments: %v", err)
+		}
+		logger.Printf("environments pushed successfully")
+	} else {
+		logger.Printf("no changes to push")
 	}
+
+	return nil
 }
 
-func promptForOverride(key string, oldValue, newValue interface{}, logger logger.Logger, reader prompt.Reader) bool {
+func promptForOverride(key string, oldValue, newValue interface{}, masker *mask.Masker, logger logger.Logger, reader prompt.Reader) (bool, error) {
 	logger.Printf("\nVariable change detected for: %s\n", key)
 	logger.Printf("─────────────────────────\n")
 
@@ -79,7 +208,7 @@ func promptForOverride(key string, oldValue, newValue interface{}, logger logger
 	if oldValue == nil {
 		logger.Printf("Currently no value exists\n")
 	} else {
-		logger.Printf("Current value: %v\n", oldValue)
+		logger.Printf("Current value: %s\n", masker.Value(key, oldValue))
 	}
 
 	// Handle display of new value and determine action type
@@ -87,10 +216,10 @@ func promptForOverride(key string, oldValue, newValue interface{}, logger logger
 	if newValue == nil || newValue == envdiff.Deleted {
 		actionMsg = "Do you want to remove this variable"
 	} else if oldValue == nil {
-		logger.Printf("New value: %v\n", newValue)
+		logger.Printf("New value: %s\n", masker.Value(key, newValue))
 		actionMsg = "Do you want to add this variable"
 	} else {
-		logger.Printf("New value: %v\n", newValue)
+		logger.Printf("New value: %s\n", masker.Value(key, newValue))
 		actionMsg = "Do you want to change this variable"
 	}
 	logger.Printf("─────────────────────────\n")
@@ -98,21 +227,57 @@ func promptForOverride(key string, oldValue, newValue interface{}, logger logger
 
 	response, err := reader.ReadString('\n')
 	if err != nil {
-		logger.Printf("Error reading response: %v", err)
-		return false
+		return false, fmt.Errorf("failed to read confirmation for %s: %v", key, err)
 	}
 
 	response = strings.ToLower(strings.TrimSpace(response))
-	return response == "y" || response == "yes"
+	return response == "y" || response == "yes", nil
 }
 
-func processPushUpdates(localEnvs, remoteEnvs map[string]map[string]interface{}, targetEnv *string, logger logger.Logger, reader prompt.Reader) (map[string]map[string]interface{}, error) {
+// resolveChange decides whether a change is applied, asking the user when no policy settles it
+func resolveChange(envName string, change envdiff.Change, input PushInput, masker *mask.Masker, logger logger.Logger, reader prompt.Reader) (bool, error) {
+	policy := input.Policy
+
+	switch change.Type {
+	case envdiff.Added:
+		return true, nil
+	case envdiff.Modified:
+		if policy.OnlyAdd {
+			return false, nil
+		}
+	case envdiff.Deleted:
+		if policy.OnlyAdd || policy.NoDelete {
+			return false, nil
+		}
+	}
+
+	if policy.Yes || policy.Force {
+		return true, nil
+	}
+
+	if policy.DryRun {
+		logger.Printf("would ask for confirmation before %s change of %s in environment: %s", strings.ToLower(string(change.Type)), change.Name, envName)
+		return true, nil
+	}
+
+	if !input.Interactive {
+		return false, fmt.Errorf("confirmation required for %s change of %s in environment %s but stdin is not a terminal, use --yes, --no-delete, --only-add or --force", strings.ToLower(string(change.Type)), change.Name, envName)
+	}
+
+	newValue := change.NewValue
+	if change.Type == envdiff.Deleted {
+		newValue = envdiff.Deleted
+	}
+	return promptForOverride(change.Name, change.OldValue, newValue, masker, logger, reader)
+}
+
+func processPushUpdates(localEnvs, remoteEnvs map[string]map[string]interface{}, s *schema.Schema, input PushInput, logger logger.Logger, reader prompt.Reader) (map[string]map[string]interface{}, error) {
 	mergedEnvs := make(map[string]map[string]interface{})
 	hasChanges := false
 
 	// Process each local environment
 	for envName, localVars := range localEnvs {
-		if targetEnv != nil && envName != *targetEnv {
+		if input.TargetEnv != nil && envName != *input.TargetEnv {
 			continue
 		}
 
@@ -129,33 +294,47 @@ func processPushUpdates(localEnvs, remoteEnvs map[string]map[string]interface{},
 			continue
 		}
 
+		// Forced pushes replace the remote environment with the local file
+		if input.Policy.Force {
+			mergedEnvs[envName] = localVars
+			hasChanges = true
+			if input.Policy.DryRun {
+				for _, change := range diff.Changes {
+					logger.Printf("would apply %s change of %s in environment: %s", strings.ToLower(string(change.Type)), change.Name, envName)
+				}
+			}
+			logger.Printf("forcing local values for environment: %s", envName)
+			continue
+		}
+
 		// Create a copy of remote vars for merging
 		mergedVars := make(map[string]interface{})
 		for k, v := range remoteVars {
 			mergedVars[k] = v
 		}
 
-		// Process each change with user confirmation for modifications
+		// Process each change, applying policies or asking for confirmation
+		masker := newMasker(s, envName, input.Reveal)
+		envChanged := false
 		skippedChanges := false
 		for _, change := range diff.Changes {
-			switch change.Type {
-			case envdiff.Modified:
-				if promptForOverride(change.Name, change.OldValue, change.NewValue, logger, reader) {
-					mergedVars[change.Name] = change.NewValue
-					hasChanges = true
-				} else {
-					skippedChanges = true
-				}
-			case envdiff.Added:
+			apply, err := resolveChange(envName, change, input, masker, logger, reader)
+			if err != nil {
+				return nil, err
+			}
+			if !apply {
+				skippedChanges = true
+				continue
+			}
+
+			if change.Type == envdiff.Deleted {
+				delete(mergedVars, change.Name)
+			} else {
 				mergedVars[change.Name] = change.NewValue
-				hasChanges = true
-			case envdiff.Deleted:
-				if promptForOverride(change.Name, change.OldValue, "DELETED", logger, reader) {
-					delete(mergedVars, change.Name)
-					hasChanges = true
-				} else {
-					skippedChanges = true
-				}
+			}
+			envChanged = true
+			if input.Policy.DryRun {
+				logger.Printf("would apply %s change of %s in environment: %s", strings.ToLower(string(change.Type)), change.Name, envName)
 			}
 		}
 
@@ -163,7 +342,8 @@ func processPushUpdates(localEnvs, remoteEnvs map[string]map[string]interface{},
 			logger.Printf("some changes were skipped for environment: %s", envName)
 		}
 
-		if hasChanges {
+		if envChanged {
+			hasChanges = true
 			mergedEnvs[envName] = mergedVars
 			logger.Printf("processed changes for environment: %s", envName)
 		}
@@ -183,6 +363,114 @@ func processPushUpdates(localEnvs, remoteEnvs map[string]map[string]interface{},
 	return mergedEnvs, nil
 }
 
+// localSources returns the environments references and parents are read from:
+// the local files first and the remote app second
+func localSources(layout fileLayout, localEnvs, remoteEnvs map[string]map[string]interface{}) (map[string]map[string]interface{}, error) {
+	allLocal, err := processEnvFiles(layout, nil, discardLogger{})
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]map[string]interface{})
+	for envName, vars := range remoteEnvs {
+		sources[envName] = vars
+	}
+	for envName, vars := range allLocal {
+		sources[envName] = vars
+	}
+	for envName, vars := range localEnvs {
+		sources[envName] = vars
+	}
+	return sources, nil
+}
+
+// stripInherited drops the local variables that only repeat the value of a
+// parent environment, unless the remote environment overrides them already
+func stripInherited(layout fileLayout, localEnvs, remoteEnvs map[string]map[string]interface{}, parents map[string]string) (map[string]map[string]interface{}, error) {
+	if len(parents) == 0 {
+		return localEnvs, nil
+	}
+
+	sources, err := localSources(layout, localEnvs, remoteEnvs)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := envfile.ResolveInheritance(sources, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := make(map[string]map[string]interface{}, len(localEnvs))
+	for envName, vars := range localEnvs {
+		parent, ok := parents[envName]
+		if !ok {
+			stripped[envName] = vars
+			continue
+		}
+
+		own := make(map[string]interface{})
+		for k, v := range vars {
+			_, overridden := remoteEnvs[envName][k]
+			inherited, exists := resolved[parent][k]
+			if !overridden && exists && fmt.Sprintf("%v", inherited) == fmt.Sprintf("%v", v) {
+				continue
+			}
+			own[k] = v
+		}
+		stripped[envName] = own
+	}
+	return stripped, nil
+}
+
+// expandLocalEnvironments resolves the references of the local environments,
+// keeping only their own variables
+func expandLocalEnvironments(layout fileLayout, localEnvs, remoteEnvs map[string]map[string]interface{}, parents map[string]string) (map[string]map[string]interface{}, error) {
+	sources, err := localSources(layout, localEnvs, remoteEnvs)
+	if err != nil {
+		return nil, err
+	}
+	sources, err = envfile.ResolveInheritance(sources, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	expander := envfile.NewExpander(sources, os.LookupEnv)
+	expanded := make(map[string]map[string]interface{}, len(localEnvs))
+	for envName, own := range localEnvs {
+		vars, err := expander.Env(envName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand environment %s: %v", envfile.DisplayName(envName), err)
+		}
+
+		expanded[envName] = make(map[string]interface{}, len(own))
+		for k := range own {
+			expanded[envName][k] = vars[k]
+		}
+	}
+	return expanded, nil
+}
+
+// validatePushedEnvironments checks the merged state of every local environment,
+// with its inherited variables, against the schema
+func validatePushedEnvironments(s *schema.Schema, mergedEnvs, localEnvs map[string]map[string]interface{}, parents map[string]string) error {
+	resolved, err := envfile.ResolveInheritance(mergedEnvs, parents)
+	if err != nil {
+		return err
+	}
+
+	pushed := make(map[string]map[string]interface{})
+	for envName := range localEnvs {
+		if vars, ok := resolved[envName]; ok {
+			pushed[envName] = vars
+		}
+	}
+
+	if errs := validateEnvironments(s, pushed); len(errs) > 0 {
+		return fmt.Errorf("push rejected by schema: %v", errs)
+	}
+	return nil
+}
+
 func readConfigFile() (*config, error) {
 	cfgData, err := os.ReadFile(filepath.Join(".env0", "config.json"))
 	if err != nil {
@@ -197,32 +485,52 @@ func readConfigFile() (*config, error) {
 	return &cfg, nil
 }
 
-func getEnvNameFromFile(filename string) string {
-	if filename == ".env" {
-		return ""
+func writeConfigFile(cfg *config) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %v", err)
 	}
-	return strings.TrimPrefix(filename, ".env.")
+
+	if err := envfile.WriteFileAtomic(filepath.Join(".env0", "config.json"), data); err != nil {
+		return fmt.Errorf("failed to write config file: %v", err)
+	}
+	return nil
 }
 
-func processEnvFiles(targetEnv *string, logger logger.Logger) (map[string]map[string]interface{}, error) {
+// readEnvParents returns the inheritance declared in the config, if the app is initialized
+func readEnvParents() (map[string]string, error) {
+	if _, err := os.Stat(filepath.Join(".env0", "config.json")); err != nil {
+		return nil, nil
+	}
+
+	cfg, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.parents(), nil
+}
+
+// processEnvFiles reads the environment files of the layout
+func processEnvFiles(layout fileLayout, targetEnv *string, logger logger.Logger) (map[string]map[string]interface{}, error) {
 	envs := make(map[string]map[string]interface{})
 
-	files, err := os.ReadDir(".")
+	files, err := layout.files()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %v", err)
+		return nil, err
 	}
 
-	for _, fi := range files {
-		name := fi.Name()
-		if name != ".env" && !strings.HasPrefix(name, ".env.") {
-			continue
-		}
+	envNames := make([]string, 0, len(files))
+	for envName := range files {
+		envNames = append(envNames, envName)
+	}
+	sort.Strings(envNames)
 
-		envName := getEnvNameFromFile(name)
+	for _, envName := range envNames {
 		if targetEnv != nil && envName != *targetEnv {
 			continue
 		}
 
+		name := files[envName]
 		vars, err := envfile.ParseEnvFile(name)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,181 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+)
+
+// backupTimeLayout names backup directories so they sort chronologically
+const backupTimeLayout = "20060102-150405.000"
+
+// maxBackups is the number of backups kept, older ones are deleted since they hold plaintext secrets
+const maxBackups = 10
+
+// RestoreInput represents the input parameters for the restore operation
+type RestoreInput struct {
+	// BackupID selects the backup to restore, the latest one when empty
+	BackupID string
+	// List reports the available backups instead of restoring one
+	List   bool
+	DryRun bool
+}
+
+// BackupView is the reported form of a backup taken before files were replaced
+type BackupView struct {
+	ID    string   `json:"id"`
+	Files []string `json:"files"`
+}
+
+// RestoreFn represents a function that performs the restore operation
+type RestoreFn func(context.Context, RestoreInput) error
+
+// NewRestore creates a new restore function with injected dependencies
+func NewRestore(logger logger.Logger, renderer output.Renderer) RestoreFn {
+	return func(ctx context.Context, input RestoreInput) error {
+		if _, err := readConfigFile(); err != nil {
+			return err
+		}
+
+		backups, err := listBackups()
+		if err != nil {
+			return err
+		}
+
+		if input.List {
+			rows := output.Rows{
+				Headers: []string{"ID", "FILES"},
+				Empty:   "no backups found",
+			}
+			for _, b := range backups {
+				rows.Values = append(rows.Values, []string{b.ID, strings.Join(b.Files, ",")})
+			}
+			return renderer.Render(backups, rows)
+		}
+
+		if len(backups) == 0 {
+			return fmt.Errorf("no backups found")
+		}
+
+		backup := backups[len(backups)-1]
+		if input.BackupID != "" {
+			idx := slices.IndexFunc(backups, func(b BackupView) bool { return b.ID == input.BackupID })
+			if idx < 0 {
+				return fmt.Errorf("backup %s not found", input.BackupID)
+			}
+			backup = backups[idx]
+		}
+
+		backupDir := filepath.Join(backupRootDir(), backup.ID)
+		batch := envfile.NewBatch()
+		for _, file := range backup.Files {
+			if input.DryRun {
+				logger.Printf("would restore %s", file)
+				continue
+			}
+
+			content, err := os.ReadFile(filepath.Join(backupDir, file))
+			if err != nil {
+				batch.Rollback()
+				return fmt.Errorf("failed to read backup of %s: %v", file, err)
+			}
+			if err := batch.Stage(file, content); err != nil {
+				batch.Rollback()
+				return err
+			}
+		}
+
+		if input.DryRun {
+			logger.Printf("dry run: no files were restored")
+			return nil
+		}
+
+		// Back up the current files too, so a restore can be undone
+		currentDir := newBackupDir()
+		if err := batch.Commit(currentDir); err != nil {
+			return err
+		}
+		if err := pruneBackups(logger); err != nil {
+			return err
+		}
+
+		logger.Printf("restored %d files from backup %s", len(backup.Files), backup.ID)
+		logger.Printf("previous files backed up to %s", currentDir)
+		return nil
+	}
+}
+
+func backupRootDir() string {
+	return filepath.Join(".env0", "backup")
+}
+
+// newBackupDir returns a fresh timestamped directory for a backup
+func newBackupDir() string {
+	return filepath.Join(backupRootDir(), time.Now().Format(backupTimeLayout))
+}
+
+// pruneBackups deletes every backup but the latest maxBackups
+func pruneBackups(logger logger.Logger) error {
+	backups, err := listBackups()
+	if err != nil {
+		return err
+	}
+
+	for len(backups) > maxBackups {
+		if err := os.RemoveAll(filepath.Join(backupRootDir(), backups[0].ID)); err != nil {
+			return fmt.Errorf("failed to delete backup %s: %v", backups[0].ID, err)
+		}
+		logger.Printf("deleted old backup %s", backups[0].ID)
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// listBackups returns the backups in chronological order
+func listBackups() ([]BackupView, error) {
+	entries, err := os.ReadDir(backupRootDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []BackupView{}, nil
+		}
+		return nil, fmt.Errorf("failed to read backups: %v", err)
+	}
+
+	backups := []BackupView{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		dir := filepath.Join(backupRootDir(), entry.Name())
+		var files []string
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			files = append(files, rel)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup %s: %v", entry.Name(), err)
+		}
+
+		backups = append(backups, BackupView{ID: entry.Name(), Files: files})
+	}
+
+	// os.ReadDir sorts by name, which is chronological for backup IDs
+	return backups, nil
+}
@@ -0,0 +1,202 @@
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envdiff"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/prompt"
+)
+
+// stopTimeout is how long a command restarted by run --watch has to exit before it is killed
+const stopTimeout = 10 * time.Second
+
+// RunInput represents the input parameters for the run operation
+type RunInput struct {
+	EnvName string
+	Local   bool
+	File    string
+	// Command is the program and its arguments
+	Command []string
+	// Dir is the working directory of the command, the current one when empty
+	Dir string
+	// Watch polls the environment and restarts the command when it changes
+	Watch bool
+	// Interval between polls in watch mode, must be positive
+	Interval time.Duration
+	// Signal is sent to the command on changes instead of restarting it, like
+	// HUP. Only local files can be reloaded by the command, so it requires
+	// Local or File.
+	Signal string
+}
+
+// ExitError reports the exit code of a command run by env0
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command exited with status %d", e.Code)
+}
+
+// RunFn represents a function that performs the run operation
+type RunFn func(context.Context, RunInput) error
+
+// NewRun creates a new run function with injected dependencies
+func NewRun(c client.Client, logger logger.Logger) RunFn {
+	return func(ctx context.Context, input RunInput) error {
+		if len(input.Command) == 0 {
+			return fmt.Errorf("no command given")
+		}
+
+		if input.Watch && input.Interval <= 0 {
+			return fmt.Errorf("--interval must be positive, got %s", input.Interval)
+		}
+
+		var reload os.Signal
+		if input.Signal != "" {
+			if !input.Watch {
+				return fmt.Errorf("--signal requires --watch")
+			}
+			// A signal cannot hand remote values to a running process, only
+			// make it read its env file again
+			if !input.Local && input.File == "" {
+				return fmt.Errorf("--signal requires --local or --file, remote changes can only reach the command by restarting it")
+			}
+			sig, err := parseSignal(input.Signal)
+			if err != nil {
+				return err
+			}
+			reload = sig
+		}
+
+		vars, err := loadResolvedEnvironment(ctx, c, input.EnvName, input.Local, input.File, logger)
+		if err != nil {
+			return err
+		}
+
+		cmd, err := startCommand(input.Command, input.Dir, vars)
+		if err != nil {
+			return err
+		}
+
+		// Forward termination signals to the child and wait for it to exit. The
+		// terminal already delivers Ctrl+C to the whole process group, child
+		// included, so interrupts are only forwarded when stdin is not a terminal.
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(signals)
+		forwardInterrupt := !prompt.IsTerminal(os.Stdin)
+
+		done := waitCommand(cmd)
+
+		var ticks <-chan time.Time
+		if input.Watch {
+			interval := input.Interval
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			ticks = ticker.C
+			logger.Printf("watching environment %s every %s", envfile.DisplayName(input.EnvName), interval)
+		}
+
+		for {
+			select {
+			case sig := <-signals:
+				if sig == os.Interrupt && !forwardInterrupt {
+					continue
+				}
+				cmd.Process.Signal(sig)
+			case err := <-done:
+				return commandResult(err)
+			case <-ticks:
+				next, err := loadResolvedEnvironment(ctx, c, input.EnvName, input.Local, input.File, discardLogger{})
+				if err != nil {
+					logger.Printf("failed to refresh environment: %v", err)
+					continue
+				}
+
+				diff := envdiff.CompareMaps(vars, next)
+				if len(diff.Changes) == 0 {
+					continue
+				}
+				vars = next
+
+				if reload != nil {
+					logger.Printf("environment %s changed, sending %s to %s", envfile.DisplayName(input.EnvName), input.Signal, input.Command[0])
+					cmd.Process.Signal(reload)
+					continue
+				}
+
+				logger.Printf("environment %s changed, restarting %s", envfile.DisplayName(input.EnvName), input.Command[0])
+				stopCommand(cmd, done)
+				cmd, err = startCommand(input.Command, input.Dir, vars)
+				if err != nil {
+					return err
+				}
+				done = waitCommand(cmd)
+			}
+		}
+	}
+}
+
+// waitCommand reports the exit of a started command on the returned channel
+func waitCommand(cmd *exec.Cmd) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	return done
+}
+
+// stopCommand asks the command to terminate and kills it if it is still
+// running after stopTimeout
+func stopCommand(cmd *exec.Cmd, done <-chan error) {
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		cmd.Process.Kill()
+	}
+
+	select {
+	case <-done:
+	case <-time.After(stopTimeout):
+		cmd.Process.Kill()
+		<-done
+	}
+}
+
+// startCommand starts the program with the variables added to the current environment
+func startCommand(command []string, dir string, vars map[string]interface{}) (*exec.Cmd, error) {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	for k, v := range vars {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %v", command[0], err)
+	}
+	return cmd, nil
+}
+
+// commandResult turns a non-zero exit status into an ExitError. A command
+// killed by a signal exits with 128 plus the signal number, like in a shell.
+func commandResult(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return &ExitError{Code: 128 + int(status.Signal())}
+		}
+		return &ExitError{Code: exitErr.ExitCode()}
+	}
+	return err
+}
@@ -0,0 +1,179 @@
+package scripts
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+	"github.com/Jibaru/env0/pkg/schema"
+)
+
+// minSecretLength is the shortest value searched for in staged files,
+// shorter ones like "true" or "8080" would match almost anything
+const minSecretLength = 8
+
+// Reasons reported by scan
+const (
+	ReasonEnvFile = "env file"
+	ReasonSecret  = "secret value"
+)
+
+// ScanInput represents the input parameters for the scan operation
+type ScanInput struct {
+	// Local only checks file names, without fetching the app's values
+	Local bool
+}
+
+// ScanFinding is a staged file that should not be committed
+type ScanFinding struct {
+	File        string `json:"file"`
+	Reason      string `json:"reason"`
+	Environment string `json:"environment,omitempty"`
+	Key         string `json:"key,omitempty"`
+}
+
+// ScanFn represents a function that performs the scan operation
+type ScanFn func(context.Context, ScanInput) error
+
+// secret is a sensitive value of the app and where it comes from
+type secret struct {
+	envName string
+	key     string
+	value   []byte
+}
+
+// NewScan creates a new scan function with injected dependencies
+func NewScan(c client.Client, logger logger.Logger, renderer output.Renderer) ScanFn {
+	return func(ctx context.Context, input ScanInput) error {
+		out, err := gitOutput(ctx, "diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR")
+		if err != nil {
+			return err
+		}
+
+		var files []string
+		for _, name := range strings.Split(string(out), "\x00") {
+			if name != "" {
+				files = append(files, name)
+			}
+		}
+
+		var secrets []secret
+		if !input.Local {
+			secrets, err = loadSecrets(ctx, c, logger)
+			if err != nil {
+				return err
+			}
+		}
+
+		findings := []ScanFinding{}
+		for _, file := range files {
+			if isEnvFile(file) {
+				findings = append(findings, ScanFinding{File: file, Reason: ReasonEnvFile})
+				continue
+			}
+			if len(secrets) == 0 {
+				continue
+			}
+
+			// The staged content is what gets committed, not the working tree
+			content, err := gitOutput(ctx, "show", ":"+file)
+			if err != nil {
+				return err
+			}
+			for _, s := range secrets {
+				if bytes.Contains(content, s.value) {
+					findings = append(findings, ScanFinding{File: file, Reason: ReasonSecret, Environment: envfile.DisplayName(s.envName), Key: s.key})
+				}
+			}
+		}
+
+		rows := output.Rows{
+			Headers: []string{"FILE", "REASON", "ENVIRONMENT", "KEY"},
+			Empty:   fmt.Sprintf("no env files or secrets in %d staged files", len(files)),
+		}
+		for _, f := range findings {
+			rows.Values = append(rows.Values, []string{f.File, f.Reason, f.Environment, f.Key})
+		}
+		if err := renderer.Render(findings, rows); err != nil {
+			return err
+		}
+
+		if len(findings) > 0 {
+			return fmt.Errorf("found %d env files or secrets staged for commit, unstage them or commit with --no-verify", len(findings))
+		}
+		return nil
+	}
+}
+
+// isEnvFile reports whether a path is an env file other than the example file
+func isEnvFile(path string) bool {
+	base := filepath.Base(path)
+	if base == DefaultExampleFile {
+		return false
+	}
+	return base == ".env" || strings.HasPrefix(base, ".env.")
+}
+
+// loadSecrets fetches the sensitive values of every environment of the app,
+// returning none when the directory is not an env0 app or the app cannot be
+// fetched, so the hook does not block commits while offline
+func loadSecrets(ctx context.Context, c client.Client, logger logger.Logger) ([]secret, error) {
+	if _, err := os.Stat(filepath.Join(".env0", "config.json")); errors.Is(err, fs.ErrNotExist) {
+		logger.Printf("no env0 app in this directory, only checking file names")
+		return nil, nil
+	}
+
+	cfg, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := loadSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+	envs, err := c.GetApp(ctx, fullAppName)
+	if err != nil {
+		logger.Printf("failed to fetch environments: %v, only checking file names", err)
+		return nil, nil
+	}
+
+	var secrets []secret
+	seen := make(map[string]bool)
+	for envName, vars := range envs {
+		var rules map[string]schema.Rule
+		if s != nil {
+			rules = s.RulesFor(envName)
+		}
+		for key, v := range vars {
+			value := fmt.Sprintf("%v", v)
+			// References are not secrets by themselves
+			if len(value) < minSecretLength || strings.Contains(value, "${") || seen[value] {
+				continue
+			}
+			if s != nil && !rules[key].IsSensitive() {
+				continue
+			}
+			seen[value] = true
+			secrets = append(secrets, secret{envName: envName, key: key, value: []byte(value)})
+		}
+	}
+
+	slices.SortFunc(secrets, func(a, b secret) int {
+		return cmp.Or(cmp.Compare(a.envName, b.envName), cmp.Compare(a.key, b.key))
+	})
+	return secrets, nil
+}
@@ -0,0 +1,224 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+)
+
+// Variable states reported by status
+const (
+	StateSynced     = "synced"
+	StateModified   = "modified"
+	StateLocalOnly  = "local only"
+	StateRemoteOnly = "remote only"
+)
+
+// Variable origins reported by status
+const (
+	OriginOwn       = "own"
+	OriginInherited = "inherited"
+	OriginOverride  = "override"
+)
+
+// StatusInput represents the input parameters for the status operation
+type StatusInput struct {
+	TargetEnv *string
+	// All compares every app of the workspace
+	All bool
+}
+
+// StatusView is the reported state of a variable in the local files and the remote app
+type StatusView struct {
+	// App is the workspace directory of the app, when comparing a whole workspace
+	App         string `json:"app,omitempty"`
+	Environment string `json:"environment"`
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Origin      string `json:"origin"`
+	// Parent is the environment the value is inherited from or overrides
+	Parent string `json:"parent,omitempty"`
+}
+
+// StatusFn represents a function that performs the status operation
+type StatusFn func(context.Context, StatusInput) error
+
+// NewStatus creates a new status function with injected dependencies
+func NewStatus(c client.Client, logger logger.Logger, renderer output.Renderer) StatusFn {
+	return func(ctx context.Context, input StatusInput) error {
+		if !input.All {
+			views, err := appStatus(ctx, c, input.TargetEnv, logger)
+			if err != nil {
+				return err
+			}
+			return renderer.Render(views, statusRows(views, false))
+		}
+
+		ws, err := findWorkspace()
+		if err != nil {
+			return err
+		}
+
+		views := []StatusView{}
+		err = ws.Each(logger, func(app WorkspaceApp) error {
+			appViews, err := appStatus(ctx, c, input.TargetEnv, logger)
+			if err != nil {
+				return err
+			}
+			for _, v := range appViews {
+				v.App = app.Dir
+				views = append(views, v)
+			}
+			return nil
+		})
+		// Report the apps that could be compared even if others failed
+		if renderErr := renderer.Render(views, statusRows(views, true)); renderErr != nil {
+			return renderErr
+		}
+		return err
+	}
+}
+
+// appStatus compares the local environments of the app in the working directory with the remote ones
+func appStatus(ctx context.Context, c client.Client, targetEnv *string, logger logger.Logger) ([]StatusView, error) {
+	cfg, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+	logger.Printf("comparing local environments with app %s", fullAppName)
+
+	remoteEnvs, err := c.GetApp(ctx, fullAppName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch environments: %v", err)
+	}
+
+	layout, err := cfg.layout()
+	if err != nil {
+		return nil, err
+	}
+	localEnvs, err := processEnvFiles(layout, targetEnv, discardLogger{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Child files may hold their own variables only, so they are compared with
+	// their parents merged in, read from the local files first
+	parents := cfg.parents()
+	sources, err := localSources(layout, localEnvs, remoteEnvs)
+	if err != nil {
+		return nil, err
+	}
+	resolvedLocal, err := envfile.ResolveInheritance(sources, parents)
+	if err != nil {
+		return nil, err
+	}
+	for envName := range localEnvs {
+		localEnvs[envName] = resolvedLocal[envName]
+	}
+
+	return environmentStatus(localEnvs, remoteEnvs, parents, targetEnv)
+}
+
+func statusRows(views []StatusView, withApp bool) output.Rows {
+	rows := output.Rows{
+		Headers: []string{"ENV", "KEY", "STATE", "ORIGIN"},
+		Empty:   "no variables found",
+	}
+	if withApp {
+		rows.Headers = append([]string{"APP"}, rows.Headers...)
+	}
+
+	for _, v := range views {
+		origin := v.Origin
+		if v.Parent != "" {
+			origin = fmt.Sprintf("%s (%s)", v.Origin, v.Parent)
+		}
+		values := []string{v.Environment, v.Key, v.State, origin}
+		if withApp {
+			values = append([]string{v.App}, values...)
+		}
+		rows.Values = append(rows.Values, values)
+	}
+	return rows
+}
+
+// environmentStatus compares every variable of the local and remote
+// environments, the remote ones resolved with their parents
+func environmentStatus(localEnvs, remoteEnvs map[string]map[string]interface{}, parents map[string]string, targetEnv *string) ([]StatusView, error) {
+	resolved, err := envfile.ResolveInheritance(remoteEnvs, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	var envNames []string
+	for envName := range localEnvs {
+		envNames = append(envNames, envName)
+	}
+	for envName := range resolved {
+		if _, ok := localEnvs[envName]; !ok {
+			envNames = append(envNames, envName)
+		}
+	}
+	slices.Sort(envNames)
+
+	views := []StatusView{}
+	for _, envName := range envNames {
+		if targetEnv != nil && envName != *targetEnv {
+			continue
+		}
+
+		origins, err := envfile.Origins(envName, remoteEnvs, parents)
+		if err != nil {
+			return nil, err
+		}
+
+		local, remote := localEnvs[envName], resolved[envName]
+		var keys []string
+		for k := range local {
+			keys = append(keys, k)
+		}
+		for k := range remote {
+			if _, ok := local[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			view := StatusView{Environment: envfile.DisplayName(envName), Key: k, Origin: OriginOwn}
+
+			localValue, inLocal := local[k]
+			remoteValue, inRemote := remote[k]
+			switch {
+			case !inLocal:
+				view.State = StateRemoteOnly
+			case !inRemote:
+				view.State = StateLocalOnly
+			case fmt.Sprintf("%v", localValue) != fmt.Sprintf("%v", remoteValue):
+				view.State = StateModified
+			default:
+				view.State = StateSynced
+			}
+
+			if origin, ok := origins[k]; ok {
+				if origin.Env != envName {
+					view.Origin = OriginInherited
+					view.Parent = envfile.DisplayName(origin.Env)
+				} else if origin.HasOverride {
+					view.Origin = OriginOverride
+					view.Parent = envfile.DisplayName(origin.Overrides)
+				}
+			}
+
+			views = append(views, view)
+		}
+	}
+	return views, nil
+}
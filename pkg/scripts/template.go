@@ -0,0 +1,214 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
+	"github.com/Jibaru/env0/pkg/schema"
+)
+
+// DefaultExampleFile is the template file written by template and read by check
+const DefaultExampleFile = ".env.example"
+
+// TemplateInput represents the input parameters for the template operation
+type TemplateInput struct {
+	EnvName string
+	// OutputPath is the example file to write
+	OutputPath string
+	// Placeholder replaces every value
+	Placeholder string
+	// Describe adds a comment above each key with its schema description,
+	// or the kind of value expected when the schema has none
+	Describe bool
+	Local    bool
+	File     string
+	DryRun   bool
+}
+
+// TemplateFn represents a function that performs the template operation
+type TemplateFn func(context.Context, TemplateInput) error
+
+// NewTemplate creates a new template function with injected dependencies
+func NewTemplate(c client.Client, logger logger.Logger) TemplateFn {
+	return func(ctx context.Context, input TemplateInput) error {
+		if err := checkExamplePath(input.OutputPath); err != nil {
+			return err
+		}
+
+		vars, err := loadEnvironment(ctx, c, input.EnvName, input.Local, input.File, logger)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(vars))
+		for k := range vars {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		s, err := loadSchema()
+		if err != nil {
+			return err
+		}
+		var rules map[string]schema.Rule
+		if s != nil {
+			rules = s.RulesFor(input.EnvName)
+		}
+
+		var content strings.Builder
+		for _, k := range keys {
+			if input.Describe {
+				description := rules[k].Description
+				if description == "" {
+					description = describeValue(fmt.Sprintf("%v", vars[k]))
+				}
+				fmt.Fprintf(&content, "# %s\n", description)
+			}
+			fmt.Fprintf(&content, "%s=%s\n", k, input.Placeholder)
+		}
+
+		if input.DryRun {
+			logger.Printf("would write %s with %d variables", input.OutputPath, len(keys))
+			return nil
+		}
+
+		if err := envfile.WriteFileAtomic(input.OutputPath, []byte(content.String())); err != nil {
+			return fmt.Errorf("failed to write %s: %v", input.OutputPath, err)
+		}
+
+		logger.Printf("template with %d variables written to %s", len(keys), input.OutputPath)
+		return nil
+	}
+}
+
+// checkExamplePath refuses example files the layout would read back as an
+// environment, since push and status would then treat the placeholders as values
+func checkExamplePath(path string) error {
+	if filepath.Base(path) == DefaultExampleFile {
+		return nil
+	}
+
+	layout, err := readLayout()
+	if err != nil {
+		return err
+	}
+
+	rel := path
+	if filepath.IsAbs(path) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		if rel, err = filepath.Rel(wd, path); err != nil {
+			return nil
+		}
+	}
+
+	if envName, ok := layout.envName(rel); ok {
+		return fmt.Errorf("%s would be read as environment %s by push and status, write the example to %s or outside the layout pattern %s", path, envfile.DisplayName(envName), DefaultExampleFile, layout.pattern)
+	}
+	return nil
+}
+
+// describeValue names the kind of a value without revealing it
+func describeValue(value string) string {
+	switch {
+	case value == "":
+		return "empty"
+	case value == "true" || value == "false":
+		return "boolean"
+	}
+
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return "integer"
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return "number"
+	}
+	if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+		return fmt.Sprintf("%s url", u.Scheme)
+	}
+	return "string"
+}
+
+// CheckInput represents the input parameters for the check operation
+type CheckInput struct {
+	EnvName     string
+	ExampleFile string
+	Local       bool
+	File        string
+}
+
+// CheckView is the reported drift between an example file and an environment
+type CheckView struct {
+	Environment string   `json:"environment"`
+	Missing     []string `json:"missing"`
+	Extra       []string `json:"extra"`
+}
+
+// CheckFn represents a function that performs the check operation
+type CheckFn func(context.Context, CheckInput) error
+
+// NewCheck creates a new check function with injected dependencies.
+// It fails when the environment is missing keys of the example or has extra ones.
+func NewCheck(c client.Client, logger logger.Logger, renderer output.Renderer) CheckFn {
+	return func(ctx context.Context, input CheckInput) error {
+		example, err := envfile.ParseEnvFile(input.ExampleFile)
+		if err != nil {
+			return err
+		}
+
+		vars, err := loadEnvironment(ctx, c, input.EnvName, input.Local, input.File, logger)
+		if err != nil {
+			return err
+		}
+
+		view := CheckView{
+			Environment: envfile.DisplayName(input.EnvName),
+			Missing:     []string{},
+			Extra:       []string{},
+		}
+		for k := range example {
+			if _, ok := vars[k]; !ok {
+				view.Missing = append(view.Missing, k)
+			}
+		}
+		for k := range vars {
+			if _, ok := example[k]; !ok {
+				view.Extra = append(view.Extra, k)
+			}
+		}
+		slices.Sort(view.Missing)
+		slices.Sort(view.Extra)
+
+		rows := output.Rows{
+			Headers: []string{"KEY", "STATUS"},
+			Empty:   fmt.Sprintf("environment %s matches %s", view.Environment, input.ExampleFile),
+		}
+		for _, k := range view.Missing {
+			rows.Values = append(rows.Values, []string{k, "missing"})
+		}
+		for _, k := range view.Extra {
+			rows.Values = append(rows.Values, []string{k, "extra"})
+		}
+
+		if err := renderer.Render(view, rows); err != nil {
+			return err
+		}
+
+		if len(view.Missing) > 0 || len(view.Extra) > 0 {
+			return fmt.Errorf("environment %s differs from %s: %d missing, %d extra", view.Environment, input.ExampleFile, len(view.Missing), len(view.Extra))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,140 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/mask"
+	"github.com/Jibaru/env0/pkg/output"
+	"github.com/Jibaru/env0/pkg/schema"
+)
+
+// ValidateInput represents the input parameters for the validate operation
+type ValidateInput struct {
+	// TargetEnv validates a single environment, all of them when nil
+	TargetEnv *string
+	Local     bool
+	File      string
+}
+
+// ValidateFn represents a function that performs the validate operation
+type ValidateFn func(context.Context, ValidateInput) error
+
+// NewValidate creates a new validate function with injected dependencies
+func NewValidate(c client.Client, logger logger.Logger, renderer output.Renderer) ValidateFn {
+	return func(ctx context.Context, input ValidateInput) error {
+		s, err := loadSchema()
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return fmt.Errorf("no schema found in .env0")
+		}
+
+		envs, err := loadEnvironments(ctx, c, input, logger)
+		if err != nil {
+			return err
+		}
+
+		errs := validateEnvironments(s, envs)
+
+		rows := output.Rows{
+			Headers: []string{"ENVIRONMENT", "KEY", "ERROR"},
+			Empty:   fmt.Sprintf("%d environments are valid", len(envs)),
+		}
+		for _, e := range errs {
+			rows.Values = append(rows.Values, []string{e.Environment, e.Key, e.Message})
+		}
+
+		view := errs
+		if view == nil {
+			view = schema.ValidationErrors{}
+		}
+		if err := renderer.Render(view, rows); err != nil {
+			return err
+		}
+
+		if len(errs) > 0 {
+			return fmt.Errorf("%d invalid variables", len(errs))
+		}
+		return nil
+	}
+}
+
+func loadEnvironments(ctx context.Context, c client.Client, input ValidateInput, logger logger.Logger) (map[string]map[string]interface{}, error) {
+	if input.TargetEnv != nil || input.File != "" {
+		envName := ""
+		if input.TargetEnv != nil {
+			envName = *input.TargetEnv
+		}
+		vars, err := loadEnvironment(ctx, c, envName, input.Local, input.File, logger)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]map[string]interface{}{envName: vars}, nil
+	}
+
+	cfg, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var envs map[string]map[string]interface{}
+	if input.Local {
+		layout, err := cfg.layout()
+		if err != nil {
+			return nil, err
+		}
+		envs, err = processEnvFiles(layout, nil, logger)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+		envs, err = c.GetApp(ctx, fullAppName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch environments: %v", err)
+		}
+	}
+
+	return envfile.ResolveInheritance(envs, cfg.parents())
+}
+
+// loadSchema reads the optional schema of the initialized project
+func loadSchema() (*schema.Schema, error) {
+	return schema.Load(".env0")
+}
+
+// validateEnvironments validates each environment against the schema, in name order
+func validateEnvironments(s *schema.Schema, envs map[string]map[string]interface{}) schema.ValidationErrors {
+	names := make([]string, 0, len(envs))
+	for name := range envs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var errs schema.ValidationErrors
+	for _, name := range names {
+		for _, e := range s.Validate(name, envs[name]) {
+			e.Environment = envfile.DisplayName(e.Environment)
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+// newMasker masks the values of an environment, except for variables the schema marks as not sensitive
+func newMasker(s *schema.Schema, envName string, reveal bool) *mask.Masker {
+	if s == nil {
+		return mask.New(reveal, nil)
+	}
+
+	rules := s.RulesFor(envName)
+	return mask.New(reveal, func(key string) bool {
+		return rules[key].IsSensitive()
+	})
+}
@@ -4,18 +4,25 @@ import (
 	"context"
 
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 // Version represents the current version of the application
 const Version = "v0.2.0"
 
+// VersionView is the reported version of the application
+type VersionView struct {
+	Version string `json:"version"`
+}
+
 // VersionFn represents a function that performs the version operation
 type VersionFn func(context.Context) error
 
 // NewVersion creates a new version function with injected dependencies
-func NewVersion(logger logger.Logger) VersionFn {
+func NewVersion(logger logger.Logger, renderer output.Renderer) VersionFn {
 	return func(ctx context.Context) error {
-		logger.Printf(Version)
-		return nil
+		return renderer.Render(VersionView{Version: Version}, output.Rows{
+			Values: [][]string{{Version}},
+		})
 	}
 }
@@ -0,0 +1,145 @@
+package scripts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Jibaru/env0/pkg/client"
+	"github.com/Jibaru/env0/pkg/envdiff"
+	"github.com/Jibaru/env0/pkg/envfile"
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// DefaultWatchInterval is how often watch mode polls the app
+const DefaultWatchInterval = 10 * time.Second
+
+// watchSignals are the signals run --watch can send instead of restarting the command
+var watchSignals = map[string]os.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"TERM": syscall.SIGTERM,
+}
+
+// parseSignal returns the signal named like HUP or SIGHUP
+func parseSignal(name string) (os.Signal, error) {
+	sig, ok := watchSignals[strings.TrimPrefix(strings.ToUpper(name), "SIG")]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signal %s, must be one of: HUP, INT, QUIT, TERM", name)
+	}
+	return sig, nil
+}
+
+// watchPull polls the app until interrupted, applying each remote change to
+// the env files unless the variable was also edited locally since the last poll
+func watchPull(ctx context.Context, c client.Client, cfg *config, access client.Access, base map[string]map[string]interface{}, layout fileLayout, input PullInput, logger logger.Logger) error {
+	interval := input.Interval
+	fullAppName := fmt.Sprintf("%s/%s", cfg.OwnerName, cfg.AppName)
+	logger.Printf("watching app %s every %s, press Ctrl+C to stop", fullAppName, interval)
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Printf("stopped watching app %s", fullAppName)
+			return nil
+		case <-ticker.C:
+		}
+
+		envs, err := fetchPullEnvironments(ctx, c, cfg, access, input.Inherited, discardLogger{})
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Printf("%v, retrying in %s", err, interval)
+			}
+			continue
+		}
+
+		if err := applyRemoteChanges(base, envs, layout, input.TargetEnv, logger); err != nil {
+			logger.Printf("%v, retrying in %s", err, interval)
+			continue
+		}
+		base = envs
+	}
+}
+
+// applyRemoteChanges writes the variables that changed remotely between base
+// and remote to the env files. Variables edited locally since base are kept
+// and reported instead of being marked as conflicts.
+func applyRemoteChanges(base, remote map[string]map[string]interface{}, layout fileLayout, targetEnv *string, logger logger.Logger) error {
+	batch := envfile.NewBatch()
+
+	for _, envName := range slices.Sorted(maps.Keys(remote)) {
+		if targetEnv != nil && envName != *targetEnv {
+			continue
+		}
+
+		diff := envdiff.CompareMaps(base[envName], remote[envName])
+		if len(diff.Changes) == 0 {
+			continue
+		}
+
+		fileName := layout.fileName(envName)
+		currentVars, err := envfile.ParseEnvFile(fileName)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				batch.Rollback()
+				return fmt.Errorf("failed to parse current env file %s: %v", fileName, err)
+			}
+			currentVars = make(map[string]interface{})
+		}
+
+		updated := maps.Clone(currentVars)
+		applied := 0
+		for _, change := range diff.Changes {
+			switch {
+			case sameValue(currentVars, remote[envName], change.Name):
+				// Already up to date
+			case sameValue(currentVars, base[envName], change.Name):
+				if change.Type == envdiff.Deleted {
+					delete(updated, change.Name)
+				} else {
+					updated[change.Name] = change.NewValue
+				}
+				applied++
+			default:
+				logger.Printf("kept local %s in %s, it changed both locally and remotely", change.Name, fileName)
+			}
+		}
+		if applied == 0 {
+			continue
+		}
+
+		if err := batch.Stage(fileName, envfile.Marshal(updated)); err != nil {
+			batch.Rollback()
+			return fmt.Errorf("failed to write merged env file %s: %v", fileName, err)
+		}
+		logger.Printf("applied %d remote changes to %s", applied, fileName)
+	}
+
+	// Only variables untouched locally are replaced, so no backup is needed
+	return batch.Commit("")
+}
+
+// sameValue reports whether a variable is set to the same value in both environments, or unset in both
+func sameValue(a, b map[string]interface{}, key string) bool {
+	valueA, existsA := a[key]
+	valueB, existsB := b[key]
+	if existsA != existsB {
+		return false
+	}
+	return fmt.Sprintf("%v", valueA) == fmt.Sprintf("%v", valueB)
+}
@@ -2,10 +2,12 @@ package scripts
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Jibaru/env0/pkg/auth"
 	"github.com/Jibaru/env0/pkg/client"
 	"github.com/Jibaru/env0/pkg/logger"
+	"github.com/Jibaru/env0/pkg/output"
 )
 
 // WhoAmIInput represents the input parameters for the whoami operation
@@ -13,34 +15,54 @@ type WhoAmIInput struct {
 	// Empty since we don't need any input parameters
 }
 
+// WhoAmIView is the reported authentication status of the current user
+type WhoAmIView struct {
+	Authenticated bool   `json:"authenticated"`
+	Username      string `json:"username,omitempty"`
+	Email         string `json:"email,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
 // WhoAmIFn represents a function that performs the whoami operation
 type WhoAmIFn func(context.Context, WhoAmIInput) error
 
 // NewWhoAmI creates a new whoami function with injected dependencies
-func NewWhoAmI(c client.Client, logger logger.Logger) WhoAmIFn {
+func NewWhoAmI(c client.Client, logger logger.Logger, renderer output.Renderer) WhoAmIFn {
 	return func(ctx context.Context, input WhoAmIInput) error {
-		authData, err := auth.Load()
-		if err != nil {
-			logger.Printf("Status: Not authenticated")
-			logger.Printf("Reason: %v", err)
-			return nil
-		}
+		var view WhoAmIView
 
-		if !authData.IsAuthenticated() {
-			logger.Printf("Status: Not authenticated")
-			logger.Printf("Reason: Token is invalid or expired")
-			return nil
+		authData, err := auth.Load()
+		switch {
+		case err != nil:
+			view.Reason = fmt.Sprintf("%v", err)
+		case !authData.IsAuthenticated():
+			view.Reason = "Token is invalid or expired"
+		default:
+			view.Authenticated = true
+			view.Username = authData.User.Username
+			view.Email = authData.User.Email
 		}
 
-		logger.Printf("Status: Authenticated")
+		return renderer.Render(view, whoAmIRows(view, authData))
+	}
+}
 
-		if authData.HasUserInfo() {
-			logger.Printf("Username: %s", authData.User.Username)
-			logger.Printf("Email: %s", authData.User.Email)
-		} else {
-			logger.Printf("Note: Login again to see full user information")
-		}
+func whoAmIRows(view WhoAmIView, authData *auth.Auth) output.Rows {
+	if !view.Authenticated {
+		return output.Rows{Values: [][]string{
+			{"Status:", "Not authenticated"},
+			{"Reason:", view.Reason},
+		}}
+	}
 
-		return nil
+	rows := output.Rows{Values: [][]string{{"Status:", "Authenticated"}}}
+	if authData.HasUserInfo() {
+		rows.Values = append(rows.Values,
+			[]string{"Username:", view.Username},
+			[]string{"Email:", view.Email},
+		)
+	} else {
+		rows.Values = append(rows.Values, []string{"Note:", "Login again to see full user information"})
 	}
+	return rows
 }
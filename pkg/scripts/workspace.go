@@ -0,0 +1,142 @@
+package scripts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/Jibaru/env0/pkg/logger"
+)
+
+// WorkspaceFile lists the apps of a repository, at its root
+const WorkspaceFile = "env0.workspace.json"
+
+// Workspace is a set of apps living in directories of the same repository
+type Workspace struct {
+	// Root is the directory holding the workspace file
+	Root string         `json:"-"`
+	Apps []WorkspaceApp `json:"apps"`
+}
+
+// WorkspaceApp is an app of a workspace and the directory its env files live in
+type WorkspaceApp struct {
+	// App is the full name of the app, used to clone it when the directory is not initialized
+	App string `json:"app,omitempty"`
+	// Dir is relative to the workspace root
+	Dir string `json:"dir"`
+}
+
+// ChdirToProject makes the closest directory holding an app config, found walking
+// up from the current directory, the working directory. It stays put when there is none.
+func ChdirToProject() error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	dir, err := findUp(cwd, filepath.Join(".env0", "config.json"))
+	if err != nil || dir == cwd {
+		return nil
+	}
+	return os.Chdir(dir)
+}
+
+// FindWorkspace walks up from start to the closest workspace file and reads it
+func FindWorkspace(start string) (*Workspace, error) {
+	root, err := findUp(start, WorkspaceFile)
+	if err != nil {
+		return nil, fmt.Errorf("no %s found in %s or its parents", WorkspaceFile, start)
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, WorkspaceFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", WorkspaceFile, err)
+	}
+
+	var ws Workspace
+	if err := json.Unmarshal(data, &ws); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", WorkspaceFile, err)
+	}
+	for _, app := range ws.Apps {
+		if app.Dir == "" || filepath.IsAbs(app.Dir) {
+			return nil, fmt.Errorf("invalid %s: app directories must be relative to the workspace root", WorkspaceFile)
+		}
+	}
+
+	ws.Root = root
+	return &ws, nil
+}
+
+// Each runs fn for every app of the workspace with the app directory as working
+// directory, carrying on after failures, which are reported together at the end
+func (w *Workspace) Each(logger logger.Logger, fn func(app WorkspaceApp) error) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	defer os.Chdir(cwd)
+
+	var failed int
+	for _, app := range w.Apps {
+		name := app.Dir
+		if app.App != "" {
+			name = fmt.Sprintf("%s (%s)", app.Dir, app.App)
+		}
+		logger.Printf("== %s", name)
+
+		if err := os.Chdir(filepath.Join(w.Root, app.Dir)); err != nil {
+			logger.Printf("failed: %v", err)
+			failed++
+			continue
+		}
+		if err := fn(app); err != nil {
+			logger.Printf("failed: %v", err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d apps failed", failed, len(w.Apps))
+	}
+	return nil
+}
+
+// findUp returns the closest directory, from start up to the filesystem root, holding name
+func findUp(start, name string) (string, error) {
+	dir, err := filepath.Abs(start)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return dir, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fs.ErrNotExist
+		}
+		dir = parent
+	}
+}
+
+// findWorkspace finds the workspace of the working directory
+func findWorkspace() (*Workspace, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return FindWorkspace(cwd)
+}
+
+// isInitialized reports whether the working directory holds an app config
+func isInitialized() bool {
+	_, err := os.Stat(filepath.Join(".env0", "config.json"))
+	return err == nil
+}
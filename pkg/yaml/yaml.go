@@ -0,0 +1,198 @@
+// Package yaml adapts gopkg.in/yaml.v3 to the JSON-shaped values used across
+// env0: documents decode into maps, slices and scalars, and values encode
+// through their json tags.
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Marshal encodes a value as a YAML document.
+// Values are first encoded as JSON so struct fields follow their json tags and order.
+func Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	node, err := jsonNode(dec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode value: %v", err)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonNode reads the next JSON value as a YAML node, keeping the order of object keys
+func jsonNode(dec *json.Decoder) (*yaml.Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if t == '[' {
+			node = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		}
+		for dec.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, scalarNode("!!str", fmt.Sprintf("%v", key)))
+			}
+			value, err := jsonNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, value)
+		}
+		// Closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case nil:
+		return scalarNode("!!null", "null"), nil
+	case bool:
+		return scalarNode("!!bool", fmt.Sprintf("%t", t)), nil
+	case json.Number:
+		if strings.ContainsAny(t.String(), ".eE") {
+			return scalarNode("!!float", t.String()), nil
+		}
+		return scalarNode("!!int", t.String()), nil
+	default:
+		// Strings are quoted by the encoder when their plain form reads as another type
+		return scalarNode("!!str", fmt.Sprintf("%v", t)), nil
+	}
+}
+
+func scalarNode(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+// Unmarshal decodes a YAML document into maps, slices and scalars.
+// Numbers are returned as json.Number to keep their text, and other scalars
+// that JSON cannot represent, like timestamps, as strings.
+func Unmarshal(data []byte) (interface{}, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return nodeValue(doc.Content[0])
+}
+
+func nodeValue(n *yaml.Node) (interface{}, error) {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		return nodeValue(n.Content[0])
+	case yaml.AliasNode:
+		return nodeValue(n.Alias)
+	case yaml.SequenceNode:
+		list := make([]interface{}, 0, len(n.Content))
+		for _, item := range n.Content {
+			value, err := nodeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	case yaml.MappingNode:
+		m := make(map[string]interface{}, len(n.Content)/2)
+		var merged []*yaml.Node
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			if key.ShortTag() == "!!merge" {
+				merged = append(merged, value)
+				continue
+			}
+			v, err := nodeValue(value)
+			if err != nil {
+				return nil, err
+			}
+			m[key.Value] = v
+		}
+		// Keys of the mapping win over merged ones
+		for _, value := range merged {
+			if err := mergeInto(m, value); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	default:
+		return scalarValue(n)
+	}
+}
+
+// mergeInto adds the keys of a << merge value missing from m
+func mergeInto(m map[string]interface{}, n *yaml.Node) error {
+	sources := []*yaml.Node{n}
+	if n.Kind == yaml.SequenceNode {
+		sources = n.Content
+	}
+
+	for _, source := range sources {
+		value, err := nodeValue(source)
+		if err != nil {
+			return err
+		}
+		mapping, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("line %d: merge value must be a mapping", source.Line)
+		}
+		for k, v := range mapping {
+			if _, exists := m[k]; !exists {
+				m[k] = v
+			}
+		}
+	}
+	return nil
+}
+
+func scalarValue(n *yaml.Node) (interface{}, error) {
+	switch n.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		if err := n.Decode(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "!!int", "!!float":
+		if json.Valid([]byte(n.Value)) {
+			return json.Number(n.Value), nil
+		}
+		// Forms like 0x1F or 1_000 are converted since JSON cannot hold their text
+		var v interface{}
+		if err := n.Decode(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return n.Value, nil
+	}
+}